@@ -246,7 +246,7 @@ func NewConsumerApp(
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
-	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, bbntypes.MemStoreKey)
+	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
@@ -349,12 +349,13 @@ func NewConsumerApp(
 
 	app.BabylonKeeper = bbnkeeper.NewKeeper(
 		app.appCodec,
-		keys[bbntypes.StoreKey],
-		memKeys[bbntypes.MemStoreKey],
+		runtime.NewKVStoreService(keys[bbntypes.StoreKey]),
 		app.BankKeeper,
 		app.StakingKeeper,
 		&app.WasmKeeper, // ensure this is a pointer as we instantiate the keeper a bit later
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		bbnkeeper.WithSlashingKeeper(&app.SlashingKeeper), // pointer as the slashing keeper is instantiated below
+		bbnkeeper.WithContractOpsKeeper(wasmkeeper.NewGovPermissionKeeper(&app.WasmKeeper)),
 	)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -519,19 +520,8 @@ func NewConsumerApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
-	messageHandler := wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
-		return wasmkeeper.NewMessageHandlerChain(
-			// security layer for system integrity, should always be first in chain
-			bbnkeeper.NewIntegrityHandler(app.BabylonKeeper),
-			nested,
-			// append our custom message handler
-			bbnkeeper.NewDefaultCustomMsgHandler(app.BabylonKeeper),
-		)
-	})
-	wasmOpts = append(wasmOpts, messageHandler,
-		// add support for the custom queries
-		wasmkeeper.WithQueryHandlerDecorator(bbnkeeper.NewQueryDecorator(app.BabylonKeeper)),
-	)
+	// add the babylon message handler chain and support for the custom queries
+	wasmOpts = append(wasmOpts, babylon.NewWasmOptions(app.BabylonKeeper).All()...)
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := AllCapabilities()
@@ -798,6 +788,9 @@ func NewConsumerApp(
 	// upgrade.
 	app.setPostHandler()
 
+	// must be before Loading version
+	app.RegisterUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			logger.Error("error on loading last version", "err", err)
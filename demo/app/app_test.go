@@ -1,17 +1,29 @@
 package app
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtestdata "github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bbntypes "github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
 
 var emptyWasmOpts []wasm.Option
@@ -57,7 +69,130 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestExportAndReimportBabylonState(t *testing.T) {
+	myContractAddr := sdk.AccAddress(make([]byte, 32)).String()
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	myState := bbntypes.DefaultGenesisState(sdk.DefaultBondDenom)
+	myState.Params.BtcStakingContractAddress = myContractAddr
+	myState.HookStatuses = []bbntypes.GenesisHookStatus{
+		{ContractAddress: myContractAddr, Status: bbntypes.HookStatus{ConsecutiveFailures: 3, Suspended: true, SuspendedAtHeight: 1}},
+	}
+	myState.ContractAuthorizations = []bbntypes.ContractAuthorization{
+		{ContractAddress: myContractAddr, MsgTypes: []string{bbntypes.CustomMsgTypeMintRewards}},
+	}
+	myState.StakingMsgPolicy = bbntypes.StakingMsgPolicy{DeniedContracts: []string{myContractAddr}}
+	myState.ScheduledTasks = []bbntypes.GenesisScheduledTask{
+		{ContractAddress: myContractAddr, Phase: bbntypes.SudoHookBeginBlock, Height: 100, Work: bbntypes.ScheduledWork{Repeat: true, Interval: 10}},
+		{ContractAddress: myContractAddr, Phase: bbntypes.SudoHookEndBlock, Height: 50, Work: bbntypes.ScheduledWork{Payload: []byte("my-payload")}},
+	}
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	app.BabylonKeeper.InitGenesis(ctx, *myState)
+	exportedState := app.BabylonKeeper.ExportGenesis(ctx)
+	// the header of the first block is indexed in BeginBlocker
+	require.Len(t, exportedState.IndexedHeaders, 1)
+	myState.IndexedHeaders = exportedState.IndexedHeaders
+	require.Equal(t, myState, exportedState)
+
+	// when
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	// then it can be imported in a new chain
+	app2 := SetupWithEmptyStore(t)
+	_, err = app2.InitChain(&abci.RequestInitChain{
+		ChainId:         app2.ChainID(),
+		Time:            time.Now().UTC(),
+		ConsensusParams: &exported.ConsensusParams,
+		InitialHeight:   exported.Height,
+		AppStateBytes:   exported.AppState,
+	})
+	require.NoError(t, err)
+	_, err = app2.FinalizeBlock(&abci.RequestFinalizeBlock{Height: exported.Height})
+	require.NoError(t, err)
+	_, err = app2.Commit()
+	require.NoError(t, err)
+
+	reexported, err := app2.ExportAppStateAndValidators(false, []string{}, []string{bbntypes.ModuleName})
+	require.NoError(t, err)
+	var appState GenesisState
+	require.NoError(t, json.Unmarshal(reexported.AppState, &appState))
+	var gotState bbntypes.GenesisState
+	require.NoError(t, app2.AppCodec().UnmarshalJSON(appState[bbntypes.ModuleName], &gotState))
+	// the block of the new chain is indexed, too
+	require.Len(t, gotState.IndexedHeaders, 2)
+	assert.Equal(t, exportedState.IndexedHeaders[0], gotState.IndexedHeaders[0])
+	gotState.IndexedHeaders = exportedState.IndexedHeaders
+	assert.True(t, exportedState.Equal(&gotState), "exported %s, got %s", exportedState, &gotState)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestBabylonV2UpgradeHandler(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// v1 store with the params only
+	store := ctx.KVStore(app.GetKey(bbntypes.StoreKey))
+	store.Delete(bbntypes.StakingMsgPolicyKey)
+	store.Delete(bbntypes.CodePinsKey)
+	require.NoError(t, app.BabylonKeeper.SetParams(ctx, bbntypes.Params{MaxGasBeginBlocker: 400_000}))
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[bbntypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	// when
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeNameBabylonV2, Height: ctx.BlockHeight()}))
+
+	// then
+	params := app.BabylonKeeper.GetParams(ctx)
+	assert.Equal(t, uint32(400_000), params.MaxGasEndBlocker)
+	assert.Equal(t, uint32(bbntypes.DefaultHeaderRetentionBlocks), params.HeaderRetentionBlocks)
+	assert.True(t, store.Has(bbntypes.StakingMsgPolicyKey))
+	assert.True(t, store.Has(bbntypes.CodePinsKey))
+	gotVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, app.ModuleManager.GetVersionMap()[bbntypes.ModuleName], gotVM[bbntypes.ModuleName])
+}
+
+func TestContractStakingMsgRejected(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight(), Time: time.Now()})
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, creator, wasmtestdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	// when the contract dispatches a staking message without permission
+	execMsg, err := json.Marshal(wasmtestdata.ReflectHandleMsg{Reflect: &wasmtestdata.ReflectPayload{
+		Msgs: []wasmvmtypes.CosmosMsg{{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{
+			Validator: validators[0].OperatorAddress,
+			Amount:    wasmvmtypes.NewCoin(1, sdk.DefaultBondDenom),
+		}}}},
+	}})
+	require.NoError(t, err)
+	_, err = contractKeeper.Execute(ctx, contractAddr, creator, execMsg, nil)
+
+	// then the execution fails with the contract and message type in the error
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Contains(t, err.Error(), contractAddr.String())
+	assert.Contains(t, err.Error(), "staking message staking")
+	// and the module can report the rejection from the error when it calls the contract
+	var rejected *bbntypes.StakingMsgRejectedError
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, contractAddr.String(), rejected.ContractAddress)
+}
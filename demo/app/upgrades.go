@@ -0,0 +1,24 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeNameBabylonV2 is the name of the upgrade that migrates the babylon module store from v1 to v2
+const UpgradeNameBabylonV2 = "babylon-v2"
+
+// RegisterUpgradeHandlers registers the handlers of the chain upgrades. The babylon store exists
+// before the upgrade, so no store upgrades are required and the in-place migrations of the modules
+// are run by the handler.
+func (app *ConsumerApp) RegisterUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameBabylonV2,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+}
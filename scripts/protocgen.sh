@@ -17,6 +17,9 @@ for dir in $proto_dirs; do
   done
 done
 
+echo "Generating protobuf API code for app wiring"
+buf generate --template buf.gen.api.yml --path babylonchain/babylon/module
+
 protoc_install_proto_gen_doc
 
 echo "Generating proto docs"
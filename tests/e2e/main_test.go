@@ -98,6 +98,7 @@ func (s *BabylonSDKTestSuite) Test1ContractDeployment() {
 		Authority: s.ConsumerApp.BabylonKeeper.GetAuthority(),
 		Params: bbntypes.Params{
 			MaxGasBeginBlocker:        500_000,
+			MaxGasEndBlocker:          500_000,
 			BabylonContractAddress:    s.ConsumerContract.Babylon.String(),
 			BtcStakingContractAddress: s.ConsumerContract.BTCStaking.String(),
 		},
@@ -6,12 +6,12 @@ type ConsumerFpsResponse struct {
 
 // SingleConsumerFpResponse represents the finality provider data returned by the contract query.
 // For more details, refer to the following links:
-// https://github.com/babylonchain/babylon-contract/blob/v0.5.3/packages/apis/src/btc_staking_api.rs
-// https://github.com/babylonchain/babylon-contract/blob/v0.5.3/contracts/btc-staking/src/msg.rs
-// https://github.com/babylonchain/babylon-contract/blob/v0.5.3/contracts/btc-staking/schema/btc-staking.json
+// https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/packages/apis/src/btc_staking_api.rs
+// https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/contracts/btc-staking/src/msg.rs
+// https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/contracts/btc-staking/schema/btc-staking.json
 type SingleConsumerFpResponse struct {
 	BtcPkHex             string `json:"btc_pk_hex"`
 	SlashedBabylonHeight uint64 `json:"slashed_babylon_height"`
 	SlashedBtcHeight     uint64 `json:"slashed_btc_height"`
-	ChainID              string `json:"chain_id"`
+	ConsumerID           string `json:"consumer_id"`
 }
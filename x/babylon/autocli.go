@@ -0,0 +1,132 @@
+package babylon
+
+import autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+// The handwritten commands of the module take precedence over the generated ones of the same name.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              "babylonchain.babylon.v1beta1.Query",
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current babylon parameters",
+				},
+				{
+					RpcMethod:      "HookStatus",
+					Use:            "hook-status [contract-address]",
+					Short:          "Query the block hook status of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod:      "ContractAuthorization",
+					Use:            "authorization [contract-address]",
+					Short:          "Query the custom message types a contract is authorized to send",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod: "ContractAuthorizations",
+					Use:       "authorizations",
+					Short:     "Query the custom message authorizations of all contracts",
+				},
+				{
+					RpcMethod: "StakingMsgPolicy",
+					Use:       "staking-msg-policy",
+					Short:     "Query the policy of the contracts allowed to send staking messages",
+				},
+				{
+					RpcMethod: "FinalityProviders",
+					Use:       "finality-providers",
+					Short:     "Query the finality providers of the BTC staking contract",
+				},
+				{
+					RpcMethod: "Delegations",
+					Use:       "delegations",
+					Short:     "Query the BTC delegations of the BTC staking contract",
+				},
+				{
+					RpcMethod: "ActivatedHeight",
+					Use:       "activated-height",
+					Short:     "Query the height at which BTC staking was activated",
+				},
+				{
+					RpcMethod:      "IndexedBlock",
+					Use:            "indexed-block [height]",
+					Short:          "Query a block indexed by the BTC staking contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "Block",
+					Use:            "block [height]",
+					Short:          "Query the BTC finality status of a consumer block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod: "FinalizedBlocks",
+					Use:       "finalized-blocks",
+					Short:     "Query the BTC-finalized consumer blocks and the latest finalized height",
+				},
+				{
+					RpcMethod: "CodePins",
+					Use:       "code-pins",
+					Short:     "Query the code checksums that the Babylon contracts are pinned to",
+				},
+				{
+					RpcMethod: "HookRegistrations",
+					Use:       "hook-registrations",
+					Short:     "Query the contracts registered for the block hooks",
+				},
+			},
+		},
+		// Every Msg of the module is signed by the module authority, the gov module account by default.
+		// They can not be sent as a plain transaction, so no plain command is generated for them. Instead,
+		// each has a handwritten "<msg>-proposal" command that submits it in a governance proposal, as the
+		// autocli of this SDK version can not generate proposal commands.
+		// A new Msg that is not signed by the authority must get a command here.
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              "babylonchain.babylon.v1beta1.Msg",
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // see update-params-proposal
+				},
+				{
+					RpcMethod: "ResumeHooks",
+					Skip:      true, // see resume-hooks-proposal
+				},
+				{
+					RpcMethod: "SetContractAuthorization",
+					Skip:      true, // see set-contract-authorization-proposal
+				},
+				{
+					RpcMethod: "RemoveContractAuthorization",
+					Skip:      true, // see remove-contract-authorization-proposal
+				},
+				{
+					RpcMethod: "UpdateStakingMsgPolicy",
+					Skip:      true, // see update-staking-msg-policy-proposal
+				},
+				{
+					RpcMethod: "InstantiateBabylonContracts",
+					Skip:      true, // see instantiate-babylon-contracts-proposal
+				},
+				{
+					RpcMethod: "UpdateCodePins",
+					Skip:      true, // see update-code-pins-proposal
+				},
+				{
+					RpcMethod: "RegisterHook",
+					Skip:      true, // see register-hook-proposal
+				},
+				{
+					RpcMethod: "DeregisterHook",
+					Skip:      true, // see deregister-hook-proposal
+				},
+			},
+		},
+	}
+}
@@ -2,12 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
@@ -24,6 +26,17 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryHookStatus(),
+		GetCmdQueryContractAuthorization(),
+		GetCmdQueryContractAuthorizations(),
+		GetCmdQueryStakingMsgPolicy(),
+		GetCmdQueryFinalityProviders(),
+		GetCmdQueryDelegations(),
+		GetCmdQueryActivatedHeight(),
+		GetCmdQueryBlock(),
+		GetCmdQueryFinalizedBlocks(),
+		GetCmdQueryCodePins(),
+		GetCmdQueryHookRegistrations(),
 	)
 	return queryCmd
 }
@@ -63,3 +76,453 @@ $ %s query babylon params
 
 	return cmd
 }
+
+// GetCmdQueryHookStatus implements the hook status query command.
+func GetCmdQueryHookStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-status [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the block hook status of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consecutive failures and suspended status of the block hooks of a contract.
+
+Example:
+$ %s query babylon hook-status <contract-address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HookStatus(cmd.Context(), &types.QueryHookStatusRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Status)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractAuthorization implements the contract authorization query command.
+func GetCmdQueryContractAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorization [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the Babylon custom messages a contract is authorized to dispatch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the custom message types of a contract in the authorization registry.
+
+Example:
+$ %s query babylon authorization <contract-address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractAuthorization(cmd.Context(), &types.QueryContractAuthorizationRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Authorization)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractAuthorizations implements the contract authorizations query command.
+func GetCmdQueryContractAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations",
+		Args:  cobra.NoArgs,
+		Short: "Query all contracts in the authorization registry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all contracts in the authorization registry with their custom message types.
+
+Example:
+$ %s query babylon authorizations
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractAuthorizations(cmd.Context(), &types.QueryContractAuthorizationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "authorizations")
+
+	return cmd
+}
+
+// GetCmdQueryStakingMsgPolicy implements the staking message policy query command.
+func GetCmdQueryStakingMsgPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-msg-policy",
+		Args:  cobra.NoArgs,
+		Short: "Query the policy that controls which contracts may dispatch staking messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the allow and deny lists of contracts that may dispatch staking messages.
+
+Example:
+$ %s query babylon staking-msg-policy
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingMsgPolicy(cmd.Context(), &types.QueryStakingMsgPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Policy)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFinalityProviders implements the finality providers query command.
+func GetCmdQueryFinalityProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-providers",
+		Args:  cobra.NoArgs,
+		Short: "Query the finality providers of the BTC staking contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the finality providers registered in the BTC staking contract.
+Only key based pagination is supported.
+
+Example:
+$ %s query babylon finality-providers --limit 20
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviders(cmd.Context(), &types.QueryFinalityProvidersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-providers")
+
+	return cmd
+}
+
+// GetCmdQueryDelegations implements the BTC delegations query command.
+func GetCmdQueryDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations",
+		Args:  cobra.NoArgs,
+		Short: "Query the BTC delegations of the BTC staking contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the BTC delegations stored in the BTC staking contract.
+Only key based pagination is supported.
+
+Example:
+$ %s query babylon delegations --limit 20
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Delegations(cmd.Context(), &types.QueryDelegationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+
+	return cmd
+}
+
+// GetCmdQueryActivatedHeight implements the BTC staking activation height query command.
+func GetCmdQueryActivatedHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activated-height",
+		Args:  cobra.NoArgs,
+		Short: "Query the height at which BTC staking was activated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height at which the BTC staking contract got its first active finality provider.
+
+Example:
+$ %s query babylon activated-height
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActivatedHeight(cmd.Context(), &types.QueryActivatedHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlock implements the consumer block status query command.
+func GetCmdQueryBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block <height>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a consumer block is indexed and BTC-finalized",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the consumer block at the given height is indexed by the BTC staking contract
+and whether it is BTC-finalized.
+
+Example:
+$ %s query babylon block 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height: %w", err)
+			}
+
+			res, err := queryClient.Block(cmd.Context(), &types.QueryBlockRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFinalizedBlocks implements the finalized consumer blocks query command.
+func GetCmdQueryFinalizedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized-blocks",
+		Args:  cobra.NoArgs,
+		Short: "Query the BTC-finalized consumer blocks and the latest finalized height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the BTC-finalized consumer blocks and the latest finalized height.
+The page key is the height of the last block of the previous page.
+
+Example:
+$ %s query babylon finalized-blocks --reverse --limit 10
+$ %s query babylon finalized-blocks --page-key 100
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if len(pageReq.Key) != 0 {
+				height, err := strconv.ParseUint(string(pageReq.Key), 10, 64)
+				if err != nil {
+					return fmt.Errorf("page key: %w", err)
+				}
+				pageReq.Key = sdk.Uint64ToBigEndian(height)
+			}
+
+			res, err := queryClient.FinalizedBlocks(cmd.Context(), &types.QueryFinalizedBlocksRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finalized-blocks")
+
+	return cmd
+}
+
+// GetCmdQueryCodePins implements the code pins query command.
+func GetCmdQueryCodePins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-pins",
+		Args:  cobra.NoArgs,
+		Short: "Query the code checksums that the Babylon contracts are pinned to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the code checksums that the Babylon and BTC staking contracts are pinned to.
+The block hooks of a contract that runs code outside of its pins are not called.
+
+Example:
+$ %s query babylon code-pins
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CodePins(cmd.Context(), &types.QueryCodePinsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Pins)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHookRegistrations implements the hook registrations query command.
+func GetCmdQueryHookRegistrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-registrations [hook]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the contracts registered for the block hooks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contracts registered for the block hooks in the hook registry.
+The optional hook is either begin_block or end_block.
+
+Example:
+$ %s query babylon hook-registrations
+$ %s query babylon hook-registrations end_block
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryHookRegistrationsRequest{Pagination: pageReq}
+			if len(args) != 0 {
+				req.Hook = args[0]
+			}
+
+			res, err := queryClient.HookRegistrations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "hook-registrations")
+
+	return cmd
+}
@@ -1,14 +1,62 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
 
-const flagAuthority = "authority"
+const (
+	flagAuthority                  = "authority"
+	flagBabylonContractAddress     = "babylon-contract-address"
+	flagBTCStakingContractAddress  = "btc-staking-contract-address"
+	flagMaxGasBeginBlocker         = "max-gas-begin-blocker"
+	flagMaxGasEndBlocker           = "max-gas-end-blocker"
+	flagHaltOnHookFailure          = "halt-on-hook-failure"
+	flagMaxConsecutiveHookFailures = "max-consecutive-hook-failures"
+	flagHeaderRetentionBlocks      = "header-retention-blocks"
+	flagRewardsDenom               = "rewards-denom"
+	flagMaxBlockRewards            = "max-block-rewards"
+	flagMaxScheduledTasksPerBlock  = "max-scheduled-tasks-per-block"
+	flagMoniker                    = "moniker"
+	flagIdentity                   = "identity"
+	flagWebsite                    = "website"
+	flagSecurityContact            = "security-contact"
+	flagDetails                    = "details"
+	flagBabylonPk                  = "babylon-pk"
+	flagPop                        = "pop"
+	flagConsumerID                 = "consumer-id"
+	flagDefaultAllow               = "default-allow"
+	flagAllowedContracts           = "allowed-contracts"
+	flagDeniedContracts            = "denied-contracts"
+	flagAdmin                      = "admin"
+	flagBabylonChecksums           = "babylon-contract-checksums"
+	flagBTCStakingChecksums        = "btc-staking-contract-checksums"
+	flagHookGasLimit               = "hook-gas-limit"
+	flagPriority                   = "priority"
+	flagBlockInfo                  = "block-info"
+)
 
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
@@ -20,6 +68,849 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 		SilenceUsage:               true,
 	}
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewUpdateParamsProposalCmd(),
+		NewResumeHooksProposalCmd(),
+		NewSetContractAuthorizationProposalCmd(),
+		NewRemoveContractAuthorizationProposalCmd(),
+		NewUpdateStakingMsgPolicyProposalCmd(),
+		NewInstantiateBabylonContractsProposalCmd(),
+		NewUpdateCodePinsProposalCmd(),
+		NewRegisterHookProposalCmd(),
+		NewDeregisterHookProposalCmd(),
+		NewRegisterFinalityProviderCmd(),
+		NewCommitPubRandCmd(),
+		NewSubmitFinalitySigCmd(),
+	)
 	return txCmd
 }
+
+// NewUpdateParamsProposalCmd implements the command to submit a governance proposal that updates the module params.
+func NewUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal [params-json-file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Submit a governance proposal to update the Babylon module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to update the Babylon module params.
+The new params are read from the given JSON file or, when omitted, from the current on-chain params.
+The param flags that are set override the respective values.
+
+Example:
+$ %s tx babylon update-params-proposal params.json --title "Update params" --summary "..." --deposit 10000stake --from mykey
+$ %s tx babylon update-params-proposal --max-gas-end-blocker 1000000 --title "Raise gas" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(clientCtx client.Context, authority string) (sdk.Msg, error) {
+				var params types.Params
+				if len(args) != 0 {
+					bz, err := os.ReadFile(args[0])
+					if err != nil {
+						return nil, err
+					}
+					if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+						return nil, fmt.Errorf("params: %w", err)
+					}
+				} else {
+					res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+					if err != nil {
+						return nil, err
+					}
+					params = res.Params
+				}
+				if err := applyParamsFlags(&params, cmd.Flags()); err != nil {
+					return nil, err
+				}
+				if err := params.ValidateBasic(); err != nil {
+					return nil, err
+				}
+				return &types.MsgUpdateParams{Authority: authority, Params: params}, nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flagBabylonContractAddress, "", "The address of the Babylon contract")
+	cmd.Flags().String(flagBTCStakingContractAddress, "", "The address of the BTC staking contract")
+	cmd.Flags().Uint32(flagMaxGasBeginBlocker, 0, "The maximum gas of the begin block hook of a contract")
+	cmd.Flags().Uint32(flagMaxGasEndBlocker, 0, "The maximum gas of the end block hook of a contract")
+	cmd.Flags().Bool(flagHaltOnHookFailure, false, "Halt the chain when a block hook fails")
+	cmd.Flags().Uint32(flagMaxConsecutiveHookFailures, 0, "The number of consecutive failures after which the hooks of a contract are disabled, 0 to never disable")
+	cmd.Flags().Uint32(flagHeaderRetentionBlocks, 0, "The number of recent block headers that are kept indexed")
+	cmd.Flags().String(flagRewardsDenom, "", "The denom of the block rewards that contracts can mint")
+	cmd.Flags().Uint64(flagMaxBlockRewards, 0, "The maximum amount of block rewards that contracts can mint per block, 0 to disable minting")
+	cmd.Flags().Uint32(flagMaxScheduledTasksPerBlock, 0, "The maximum number of scheduled tasks executed at each of begin and end block")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// applyParamsFlags overrides the params with the param flags that are set
+func applyParamsFlags(params *types.Params, fs *flag.FlagSet) error {
+	var err error
+	if fs.Changed(flagBabylonContractAddress) {
+		if params.BabylonContractAddress, err = fs.GetString(flagBabylonContractAddress); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagBTCStakingContractAddress) {
+		if params.BtcStakingContractAddress, err = fs.GetString(flagBTCStakingContractAddress); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagMaxGasBeginBlocker) {
+		if params.MaxGasBeginBlocker, err = fs.GetUint32(flagMaxGasBeginBlocker); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagMaxGasEndBlocker) {
+		if params.MaxGasEndBlocker, err = fs.GetUint32(flagMaxGasEndBlocker); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagHaltOnHookFailure) {
+		if params.HaltOnHookFailure, err = fs.GetBool(flagHaltOnHookFailure); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagMaxConsecutiveHookFailures) {
+		if params.MaxConsecutiveHookFailures, err = fs.GetUint32(flagMaxConsecutiveHookFailures); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagHeaderRetentionBlocks) {
+		if params.HeaderRetentionBlocks, err = fs.GetUint32(flagHeaderRetentionBlocks); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagRewardsDenom) {
+		if params.RewardsDenom, err = fs.GetString(flagRewardsDenom); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagMaxBlockRewards) {
+		if params.MaxBlockRewards, err = fs.GetUint64(flagMaxBlockRewards); err != nil {
+			return err
+		}
+	}
+	if fs.Changed(flagMaxScheduledTasksPerBlock) {
+		if params.MaxScheduledTasksPerBlock, err = fs.GetUint32(flagMaxScheduledTasksPerBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewResumeHooksProposalCmd implements the command to submit a governance proposal that resumes the
+// block hooks of a contract suspended by the circuit breaker.
+func NewResumeHooksProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-hooks-proposal [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to resume the suspended block hooks of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to resume the block hooks of a contract that were suspended
+by the circuit breaker. The failure counter of the contract is reset.
+
+Example:
+$ %s tx babylon resume-hooks-proposal bbnc1... --title "Resume hooks" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseResumeHooksMsg(authority, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseResumeHooksMsg builds the resume hooks message from the command arguments
+func parseResumeHooksMsg(authority string, args []string) (*types.MsgResumeHooks, error) {
+	if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+		return nil, fmt.Errorf("contract address: %w", err)
+	}
+	return &types.MsgResumeHooks{Authority: authority, ContractAddress: args[0]}, nil
+}
+
+// NewSetContractAuthorizationProposalCmd implements the command to submit a governance proposal that sets
+// the custom message types a contract is authorized to send.
+func NewSetContractAuthorizationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-authorization-proposal [contract-address] [msg-type]...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Submit a governance proposal to authorize a contract to send custom messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to authorize a contract to send the given custom message types.
+An existing authorization of the contract is replaced.
+
+Example:
+$ %s tx babylon set-contract-authorization-proposal bbnc1... mint_rewards --title "Authorize minting" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseSetContractAuthorizationMsg(authority, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseSetContractAuthorizationMsg builds the contract authorization message from the command arguments
+func parseSetContractAuthorizationMsg(authority string, args []string) (*types.MsgSetContractAuthorization, error) {
+	authz := types.ContractAuthorization{ContractAddress: args[0], MsgTypes: args[1:]}
+	if err := authz.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetContractAuthorization{Authority: authority, Authorization: authz}, nil
+}
+
+// NewRemoveContractAuthorizationProposalCmd implements the command to submit a governance proposal that
+// removes the custom message authorization of a contract.
+func NewRemoveContractAuthorizationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-contract-authorization-proposal [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to remove the custom message authorization of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to remove the custom message authorization of a contract.
+
+Example:
+$ %s tx babylon remove-contract-authorization-proposal bbnc1... --title "Revoke minting" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseRemoveContractAuthorizationMsg(authority, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseRemoveContractAuthorizationMsg builds the authorization removal message from the command arguments
+func parseRemoveContractAuthorizationMsg(authority string, args []string) (*types.MsgRemoveContractAuthorization, error) {
+	if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+		return nil, fmt.Errorf("contract address: %w", err)
+	}
+	return &types.MsgRemoveContractAuthorization{Authority: authority, ContractAddress: args[0]}, nil
+}
+
+// NewUpdateStakingMsgPolicyProposalCmd implements the command to submit a governance proposal that replaces
+// the policy of the contracts allowed to send staking messages.
+func NewUpdateStakingMsgPolicyProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-staking-msg-policy-proposal",
+		Args:  cobra.NoArgs,
+		Short: "Submit a governance proposal to update the policy of the contracts allowed to send staking messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to update the policy of the contracts allowed to send staking messages.
+The policy is replaced as a whole. The deny list takes precedence over the allow list.
+
+Example:
+$ %s tx babylon update-staking-msg-policy-proposal --allowed-contracts bbnc1...,bbnc1... --title "Staking policy" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseUpdateStakingMsgPolicyMsg(authority, cmd.Flags())
+			})
+		},
+	}
+
+	cmd.Flags().Bool(flagDefaultAllow, false, "Allow the contracts that are in neither list to send staking messages")
+	cmd.Flags().StringSlice(flagAllowedContracts, nil, "The contracts that may send staking messages")
+	cmd.Flags().StringSlice(flagDeniedContracts, nil, "The contracts that must not send staking messages")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseUpdateStakingMsgPolicyMsg builds the staking message policy update from the command flags
+func parseUpdateStakingMsgPolicyMsg(authority string, fs *flag.FlagSet) (*types.MsgUpdateStakingMsgPolicy, error) {
+	var policy types.StakingMsgPolicy
+	var err error
+	if policy.DefaultAllow, err = fs.GetBool(flagDefaultAllow); err != nil {
+		return nil, err
+	}
+	if policy.AllowedContracts, err = fs.GetStringSlice(flagAllowedContracts); err != nil {
+		return nil, err
+	}
+	if policy.DeniedContracts, err = fs.GetStringSlice(flagDeniedContracts); err != nil {
+		return nil, err
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateStakingMsgPolicy{Authority: authority, Policy: policy}, nil
+}
+
+// NewInstantiateBabylonContractsProposalCmd implements the command to submit a governance proposal that
+// instantiates the Babylon and BTC staking contracts and stores their addresses in the params.
+func NewInstantiateBabylonContractsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-babylon-contracts-proposal [babylon-code-id] [btc-staking-code-id] [babylon-init-msg] [btc-staking-init-msg]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a governance proposal to instantiate the Babylon and BTC staking contracts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to instantiate the Babylon and BTC staking contracts.
+The init messages are JSON encoded. The btc_staking_code_id and btc_staking_msg fields of the
+Babylon init message are set by the module.
+
+Example:
+$ %s tx babylon instantiate-babylon-contracts-proposal 1 2 '{"network":"regtest",...}' '{}' --admin bbnc1... --title "Instantiate contracts" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseInstantiateBabylonContractsMsg(authority, args, cmd.Flags())
+			})
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "The optional admin of the Babylon contract")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseInstantiateBabylonContractsMsg builds the contracts instantiation message from the command arguments and flags
+func parseInstantiateBabylonContractsMsg(authority string, args []string, fs *flag.FlagSet) (*types.MsgInstantiateBabylonContracts, error) {
+	babylonCodeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("babylon code id: %w", err)
+	}
+	btcStakingCodeID, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("btc staking code id: %w", err)
+	}
+	msg := &types.MsgInstantiateBabylonContracts{
+		Authority:                authority,
+		BabylonContractCodeId:    babylonCodeID,
+		BtcStakingContractCodeId: btcStakingCodeID,
+		BabylonInitMsg:           wasmtypes.RawContractMessage(args[2]),
+		BtcStakingInitMsg:        wasmtypes.RawContractMessage(args[3]),
+	}
+	if err := msg.BabylonInitMsg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("babylon init msg: %w", err)
+	}
+	if err := msg.BtcStakingInitMsg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("btc staking init msg: %w", err)
+	}
+	if msg.Admin, err = fs.GetString(flagAdmin); err != nil {
+		return nil, err
+	}
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, fmt.Errorf("admin: %w", err)
+		}
+	}
+	return msg, nil
+}
+
+// NewUpdateCodePinsProposalCmd implements the command to submit a governance proposal that replaces the
+// code checksums that the Babylon contracts are pinned to.
+func NewUpdateCodePinsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-code-pins-proposal",
+		Args:  cobra.NoArgs,
+		Short: "Submit a governance proposal to update the code checksums that the Babylon contracts are pinned to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to update the code checksums that the Babylon contracts are pinned to.
+The pins are replaced as a whole. A contract without checksums is not pinned.
+
+Example:
+$ %s tx babylon update-code-pins-proposal --btc-staking-contract-checksums 5b1e...0f --title "Pin code" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseUpdateCodePinsMsg(authority, cmd.Flags())
+			})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagBabylonChecksums, nil, "The hex encoded code checksums that the Babylon contract is pinned to")
+	cmd.Flags().StringSlice(flagBTCStakingChecksums, nil, "The hex encoded code checksums that the BTC staking contract is pinned to")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseUpdateCodePinsMsg builds the code pins update from the command flags
+func parseUpdateCodePinsMsg(authority string, fs *flag.FlagSet) (*types.MsgUpdateCodePins, error) {
+	var pins types.CodePins
+	for _, v := range []struct {
+		flag string
+		dest *[][]byte
+	}{
+		{flagBabylonChecksums, &pins.BabylonContractChecksums},
+		{flagBTCStakingChecksums, &pins.BtcStakingContractChecksums},
+	} {
+		checksums, err := fs.GetStringSlice(v.flag)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range checksums {
+			checksum, err := hex.DecodeString(c)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", v.flag, err)
+			}
+			*v.dest = append(*v.dest, checksum)
+		}
+	}
+	if err := pins.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateCodePins{Authority: authority, Pins: pins}, nil
+}
+
+// NewRegisterHookProposalCmd implements the command to submit a governance proposal that registers a
+// contract for a block hook in the hook registry.
+func NewRegisterHookProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-hook-proposal [contract-address] [hook]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to register a contract for a block hook",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to register a contract for the "begin_block" or "end_block" hook.
+An existing registration of the contract for the same hook is replaced.
+
+Example:
+$ %s tx babylon register-hook-proposal bbnc1... end_block --priority 10 --title "Register hook" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseRegisterHookMsg(authority, args, cmd.Flags())
+			})
+		},
+	}
+
+	cmd.Flags().Uint32(flagHookGasLimit, 0, "The maximum gas of a callback to the contract, 0 to use the max gas param of the hook")
+	cmd.Flags().Uint32(flagPriority, 0, "The priority of the contract, contracts with a higher priority are called first")
+	cmd.Flags().Bool(flagBlockInfo, false, "Send the versioned block information to the contract")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseRegisterHookMsg builds the hook registration message from the command arguments and flags
+func parseRegisterHookMsg(authority string, args []string, fs *flag.FlagSet) (*types.MsgRegisterHook, error) {
+	reg := types.HookRegistration{ContractAddress: args[0], Hook: args[1]}
+	var err error
+	if reg.GasLimit, err = fs.GetUint32(flagHookGasLimit); err != nil {
+		return nil, err
+	}
+	if reg.Priority, err = fs.GetUint32(flagPriority); err != nil {
+		return nil, err
+	}
+	if reg.BlockInfo, err = fs.GetBool(flagBlockInfo); err != nil {
+		return nil, err
+	}
+	if err := reg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterHook{Authority: authority, Registration: reg}, nil
+}
+
+// NewDeregisterHookProposalCmd implements the command to submit a governance proposal that removes a
+// contract from a block hook of the hook registry.
+func NewDeregisterHookProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-hook-proposal [contract-address] [hook]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to deregister a contract from a block hook",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to deregister a contract from the "begin_block" or "end_block" hook.
+
+Example:
+$ %s tx babylon deregister-hook-proposal bbnc1... end_block --title "Deregister hook" --summary "..." --deposit 10000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(_ client.Context, authority string) (sdk.Msg, error) {
+				return parseDeregisterHookMsg(authority, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// parseDeregisterHookMsg builds the hook deregistration message from the command arguments
+func parseDeregisterHookMsg(authority string, args []string) (*types.MsgDeregisterHook, error) {
+	if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+		return nil, fmt.Errorf("contract address: %w", err)
+	}
+	if _, err := types.SchedulerPhaseFromHook(args[1]); err != nil {
+		return nil, err
+	}
+	return &types.MsgDeregisterHook{Authority: authority, ContractAddress: args[0], Hook: args[1]}, nil
+}
+
+// addProposalFlags adds the authority, governance proposal and transaction flags to a proposal command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "The address of the governance account")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitProposal submits a governance proposal with the message built for the authority of the authority flag
+func submitProposal(cmd *cobra.Command, buildMsg func(clientCtx client.Context, authority string) (sdk.Msg, error)) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	authority, err := cmd.Flags().GetString(flagAuthority)
+	if err != nil {
+		return fmt.Errorf("authority: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("authority: %w", err)
+	}
+	msg, err := buildMsg(clientCtx, authority)
+	if err != nil {
+		return err
+	}
+
+	proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+}
+
+// NewRegisterFinalityProviderCmd implements the command to register a finality provider with the BTC staking contract.
+func NewRegisterFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-finality-provider [btc-pk-hex] [commission]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register a finality provider with the BTC staking contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register a finality provider with the BTC staking contract.
+The proof of possession is read from a JSON file with the btc_sig_type, babylon_sig and btc_sig fields,
+where the signatures are base64 encoded.
+
+Example:
+$ %s tx babylon register-finality-provider 02f1...a3 0.05 --moniker my-fp --consumer-id my-chain --pop pop.json --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fp, err := parseNewFinalityProvider(args[0], args[1], cmd.Flags())
+			if err != nil {
+				return err
+			}
+			execMsg := contract.BTCStakingExecuteMsg{
+				BtcStaking: &contract.BtcStakingMsg{
+					NewFp:       []contract.NewFinalityProvider{fp},
+					ActiveDel:   []json.RawMessage{},
+					SlashedDel:  []json.RawMessage{},
+					UnbondedDel: []json.RawMessage{},
+				},
+			}
+			return executeBTCStakingContract(cmd, clientCtx, execMsg)
+		},
+	}
+
+	cmd.Flags().String(flagMoniker, "", "The name of the finality provider")
+	cmd.Flags().String(flagIdentity, "", "The optional identity signature of the finality provider, e.g. a Keybase key")
+	cmd.Flags().String(flagWebsite, "", "The optional website of the finality provider")
+	cmd.Flags().String(flagSecurityContact, "", "The optional security contact email of the finality provider")
+	cmd.Flags().String(flagDetails, "", "The optional details of the finality provider")
+	cmd.Flags().String(flagBabylonPk, "", "The hex encoded secp256k1 Babylon public key of the finality provider")
+	cmd.Flags().String(flagPop, "", "The JSON file with the proof of possession of the finality provider keys")
+	cmd.Flags().String(flagConsumerID, "", "The ID of the consumer chain the finality provider secures")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseNewFinalityProvider builds the finality provider to register from the command arguments and flags
+func parseNewFinalityProvider(btcPkHex, commission string, fs *flag.FlagSet) (contract.NewFinalityProvider, error) {
+	if _, err := hex.DecodeString(btcPkHex); err != nil {
+		return contract.NewFinalityProvider{}, fmt.Errorf("btc pk: %w", err)
+	}
+	rate, err := math.LegacyNewDecFromStr(commission)
+	if err != nil {
+		return contract.NewFinalityProvider{}, fmt.Errorf("commission: %w", err)
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return contract.NewFinalityProvider{}, fmt.Errorf("commission must be between 0 and 1: %s", commission)
+	}
+	consumerID, err := fs.GetString(flagConsumerID)
+	if err != nil {
+		return contract.NewFinalityProvider{}, err
+	}
+	if len(consumerID) == 0 {
+		return contract.NewFinalityProvider{}, fmt.Errorf("consumer id is required")
+	}
+	fp := contract.NewFinalityProvider{
+		Commission: rate.String(),
+		BTCPkHex:   btcPkHex,
+		ConsumerID: consumerID,
+	}
+
+	var desc contract.FinalityProviderDescription
+	for _, v := range []struct {
+		flag string
+		dest *string
+	}{
+		{flagMoniker, &desc.Moniker},
+		{flagIdentity, &desc.Identity},
+		{flagWebsite, &desc.Website},
+		{flagSecurityContact, &desc.SecurityContact},
+		{flagDetails, &desc.Details},
+	} {
+		if *v.dest, err = fs.GetString(v.flag); err != nil {
+			return contract.NewFinalityProvider{}, err
+		}
+	}
+	if desc != (contract.FinalityProviderDescription{}) {
+		fp.Description = &desc
+	}
+
+	babylonPkHex, err := fs.GetString(flagBabylonPk)
+	if err != nil {
+		return contract.NewFinalityProvider{}, err
+	}
+	if len(babylonPkHex) != 0 {
+		key, err := hex.DecodeString(babylonPkHex)
+		if err != nil {
+			return contract.NewFinalityProvider{}, fmt.Errorf("babylon pk: %w", err)
+		}
+		fp.BabylonPk = &contract.PubKey{Key: key}
+	}
+
+	popFile, err := fs.GetString(flagPop)
+	if err != nil {
+		return contract.NewFinalityProvider{}, err
+	}
+	if len(popFile) != 0 {
+		bz, err := os.ReadFile(popFile)
+		if err != nil {
+			return contract.NewFinalityProvider{}, err
+		}
+		var pop contract.ProofOfPossession
+		if err := json.Unmarshal(bz, &pop); err != nil {
+			return contract.NewFinalityProvider{}, fmt.Errorf("pop: %w", err)
+		}
+		fp.Pop = &pop
+	}
+	return fp, nil
+}
+
+// NewCommitPubRandCmd implements the command to commit public randomness of a finality provider to the BTC staking contract.
+func NewCommitPubRandCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-pub-rand [fp-btc-pk-hex] [start-height] [num-pub-rand] [commitment-hex] [signature-hex]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Commit a list of EOTS public randomness of a finality provider to the BTC staking contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a list of EOTS public randomness of a finality provider to the BTC staking contract.
+The commitment is the merkle root of the public randomness list, the signature is the Schnorr
+signature of the finality provider over the commitment.
+
+Example:
+$ %s tx babylon commit-pub-rand 02f1...a3 100 1000 9a0b...c2 6e1f...07 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseCommitPubRand(args)
+			if err != nil {
+				return err
+			}
+			return executeBTCStakingContract(cmd, clientCtx, contract.BTCStakingExecuteMsg{CommitPublicRandomness: &msg})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseCommitPubRand builds the public randomness commitment from the command arguments
+func parseCommitPubRand(args []string) (contract.CommitPublicRandomnessMsg, error) {
+	if _, err := hex.DecodeString(args[0]); err != nil {
+		return contract.CommitPublicRandomnessMsg{}, fmt.Errorf("fp btc pk: %w", err)
+	}
+	startHeight, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return contract.CommitPublicRandomnessMsg{}, fmt.Errorf("start height: %w", err)
+	}
+	numPubRand, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return contract.CommitPublicRandomnessMsg{}, fmt.Errorf("num pub rand: %w", err)
+	}
+	commitment, err := hex.DecodeString(args[3])
+	if err != nil {
+		return contract.CommitPublicRandomnessMsg{}, fmt.Errorf("commitment: %w", err)
+	}
+	signature, err := hex.DecodeString(args[4])
+	if err != nil {
+		return contract.CommitPublicRandomnessMsg{}, fmt.Errorf("signature: %w", err)
+	}
+	return contract.CommitPublicRandomnessMsg{
+		FpPubkeyHex: args[0],
+		StartHeight: startHeight,
+		NumPubRand:  numPubRand,
+		Commitment:  commitment,
+		Signature:   signature,
+	}, nil
+}
+
+// NewSubmitFinalitySigCmd implements the command to submit a finality signature of a finality provider to the BTC staking contract.
+func NewSubmitFinalitySigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-finality-sig [fp-btc-pk-hex] [height] [pub-rand-hex] [proof-json-file] [block-hash-hex] [signature-hex]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Submit the EOTS signature of a finality provider for a block to the BTC staking contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit the EOTS signature of a finality provider for a block to the BTC staking contract.
+The proof file contains the CometBFT JSON encoded merkle proof of the public randomness
+against the committed commitment.
+
+Example:
+$ %s tx babylon submit-finality-sig 02f1...a3 120 4c8e...19 proof.json 7d2a...e4 3b9f...51 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := os.ReadFile(args[3])
+			if err != nil {
+				return err
+			}
+			msg, err := parseSubmitFinalitySig(args, proof)
+			if err != nil {
+				return err
+			}
+			return executeBTCStakingContract(cmd, clientCtx, contract.BTCStakingExecuteMsg{SubmitFinalitySignature: &msg})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSubmitFinalitySig builds the finality signature submission from the command arguments and the JSON encoded proof
+func parseSubmitFinalitySig(args []string, proofJSON []byte) (contract.SubmitFinalitySignatureMsg, error) {
+	if _, err := hex.DecodeString(args[0]); err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("fp btc pk: %w", err)
+	}
+	height, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("height: %w", err)
+	}
+	pubRand, err := hex.DecodeString(args[2])
+	if err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("pub rand: %w", err)
+	}
+	var proof contract.Proof
+	if err := json.Unmarshal(proofJSON, &proof); err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("proof: %w", err)
+	}
+	blockHash, err := hex.DecodeString(args[4])
+	if err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("block hash: %w", err)
+	}
+	signature, err := hex.DecodeString(args[5])
+	if err != nil {
+		return contract.SubmitFinalitySignatureMsg{}, fmt.Errorf("signature: %w", err)
+	}
+	return contract.SubmitFinalitySignatureMsg{
+		FpPubkeyHex: args[0],
+		Height:      height,
+		PubRand:     pubRand,
+		Proof:       proof,
+		BlockHash:   blockHash,
+		Signature:   signature,
+	}, nil
+}
+
+// executeBTCStakingContract sends the execute message to the BTC staking contract configured in the module params
+func executeBTCStakingContract(cmd *cobra.Command, clientCtx client.Context, execMsg contract.BTCStakingExecuteMsg) error {
+	res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	msg, err := buildExecuteMsg(clientCtx.GetFromAddress(), res.Params.BtcStakingContractAddress, execMsg)
+	if err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// buildExecuteMsg builds the wasm execute message of the sender to the given contract
+func buildExecuteMsg(sender sdk.AccAddress, contractAddr string, execMsg any) (*wasmtypes.MsgExecuteContract, error) {
+	if len(contractAddr) == 0 {
+		return nil, fmt.Errorf("btc staking contract address not set in params")
+	}
+	bz, err := json.Marshal(execMsg)
+	if err != nil {
+		return nil, err
+	}
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contractAddr,
+		Msg:      bz,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestApplyParamsFlags(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32)).String()
+	cmd := NewUpdateParamsProposalCmd()
+	require.NoError(t, cmd.Flags().Parse([]string{
+		"--" + flagBTCStakingContractAddress, myContractAddr,
+		"--" + flagMaxGasEndBlocker, "1000000",
+		"--" + flagHaltOnHookFailure,
+	}))
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	exp := params
+	exp.BtcStakingContractAddress = myContractAddr
+	exp.MaxGasEndBlocker = 1_000_000
+	exp.HaltOnHookFailure = true
+
+	// when
+	require.NoError(t, applyParamsFlags(&params, cmd.Flags()))
+
+	// then
+	assert.Equal(t, exp, params)
+}
+
+func TestParseProposalMsgs(t *testing.T) {
+	const myAuthority = "my-authority"
+	myContractAddr := sdk.AccAddress(rand.Bytes(32)).String()
+	myChecksum := bytes.Repeat([]byte{1}, 32)
+	withFlags := func(t *testing.T, cmd *cobra.Command, args ...string) *flag.FlagSet {
+		t.Helper()
+		require.NoError(t, cmd.Flags().Parse(args))
+		return cmd.Flags()
+	}
+
+	specs := map[string]struct {
+		parse  func(t *testing.T) (sdk.Msg, error)
+		exp    sdk.Msg
+		expErr bool
+	}{
+		"resume hooks": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseResumeHooksMsg(myAuthority, []string{myContractAddr})
+			},
+			exp: &types.MsgResumeHooks{Authority: myAuthority, ContractAddress: myContractAddr},
+		},
+		"resume hooks with invalid address": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseResumeHooksMsg(myAuthority, []string{"invalid"})
+			},
+			expErr: true,
+		},
+		"set contract authorization": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseSetContractAuthorizationMsg(myAuthority, []string{myContractAddr, types.CustomMsgTypeMintRewards})
+			},
+			exp: &types.MsgSetContractAuthorization{Authority: myAuthority, Authorization: types.ContractAuthorization{
+				ContractAddress: myContractAddr, MsgTypes: []string{types.CustomMsgTypeMintRewards},
+			}},
+		},
+		"remove contract authorization": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseRemoveContractAuthorizationMsg(myAuthority, []string{myContractAddr})
+			},
+			exp: &types.MsgRemoveContractAuthorization{Authority: myAuthority, ContractAddress: myContractAddr},
+		},
+		"update staking msg policy": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				fs := withFlags(t, NewUpdateStakingMsgPolicyProposalCmd(), "--"+flagAllowedContracts, myContractAddr)
+				return parseUpdateStakingMsgPolicyMsg(myAuthority, fs)
+			},
+			exp: &types.MsgUpdateStakingMsgPolicy{Authority: myAuthority, Policy: types.StakingMsgPolicy{AllowedContracts: []string{myContractAddr}, DeniedContracts: []string{}}},
+		},
+		"instantiate babylon contracts": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				fs := withFlags(t, NewInstantiateBabylonContractsProposalCmd(), "--"+flagAdmin, myContractAddr)
+				return parseInstantiateBabylonContractsMsg(myAuthority, []string{"1", "2", `{"network":"regtest"}`, `{}`}, fs)
+			},
+			exp: &types.MsgInstantiateBabylonContracts{
+				Authority:                myAuthority,
+				BabylonContractCodeId:    1,
+				BtcStakingContractCodeId: 2,
+				BabylonInitMsg:           []byte(`{"network":"regtest"}`),
+				BtcStakingInitMsg:        []byte(`{}`),
+				Admin:                    myContractAddr,
+			},
+		},
+		"instantiate babylon contracts with invalid init msg": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseInstantiateBabylonContractsMsg(myAuthority, []string{"1", "2", `not json`, `{}`}, NewInstantiateBabylonContractsProposalCmd().Flags())
+			},
+			expErr: true,
+		},
+		"update code pins": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				fs := withFlags(t, NewUpdateCodePinsProposalCmd(), "--"+flagBTCStakingChecksums, hex.EncodeToString(myChecksum))
+				return parseUpdateCodePinsMsg(myAuthority, fs)
+			},
+			exp: &types.MsgUpdateCodePins{Authority: myAuthority, Pins: types.CodePins{BtcStakingContractChecksums: [][]byte{myChecksum}}},
+		},
+		"update code pins with invalid checksum": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				fs := withFlags(t, NewUpdateCodePinsProposalCmd(), "--"+flagBabylonChecksums, "0102")
+				return parseUpdateCodePinsMsg(myAuthority, fs)
+			},
+			expErr: true,
+		},
+		"register hook": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				fs := withFlags(t, NewRegisterHookProposalCmd(), "--"+flagHookGasLimit, "100000", "--"+flagPriority, "10", "--"+flagBlockInfo)
+				return parseRegisterHookMsg(myAuthority, []string{myContractAddr, types.SudoHookEndBlock}, fs)
+			},
+			exp: &types.MsgRegisterHook{Authority: myAuthority, Registration: types.HookRegistration{
+				ContractAddress: myContractAddr, Hook: types.SudoHookEndBlock, GasLimit: 100_000, Priority: 10, BlockInfo: true,
+			}},
+		},
+		"register unknown hook": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseRegisterHookMsg(myAuthority, []string{myContractAddr, "other"}, NewRegisterHookProposalCmd().Flags())
+			},
+			expErr: true,
+		},
+		"deregister hook": {
+			parse: func(t *testing.T) (sdk.Msg, error) {
+				return parseDeregisterHookMsg(myAuthority, []string{myContractAddr, types.SudoHookBeginBlock})
+			},
+			exp: &types.MsgDeregisterHook{Authority: myAuthority, ContractAddress: myContractAddr, Hook: types.SudoHookBeginBlock},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotMsg, gotErr := spec.parse(t)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotMsg)
+		})
+	}
+}
+
+func TestBuildBTCStakingExecuteMsgs(t *testing.T) {
+	mySender := sdk.AccAddress(rand.Bytes(20))
+	myContractAddr := sdk.AccAddress(rand.Bytes(32)).String()
+
+	specs := map[string]struct {
+		build   func() (contract.BTCStakingExecuteMsg, error)
+		expJSON string
+		expErr  bool
+	}{
+		"register finality provider": {
+			build: func() (contract.BTCStakingExecuteMsg, error) {
+				cmd := NewRegisterFinalityProviderCmd()
+				if err := cmd.Flags().Parse([]string{"--" + flagMoniker, "my-fp", "--" + flagConsumerID, "my-chain", "--" + flagBabylonPk, "0102"}); err != nil {
+					return contract.BTCStakingExecuteMsg{}, err
+				}
+				fp, err := parseNewFinalityProvider("02ab", "0.05", cmd.Flags())
+				return contract.BTCStakingExecuteMsg{BtcStaking: &contract.BtcStakingMsg{
+					NewFp:       []contract.NewFinalityProvider{fp},
+					ActiveDel:   []json.RawMessage{},
+					SlashedDel:  []json.RawMessage{},
+					UnbondedDel: []json.RawMessage{},
+				}}, err
+			},
+			expJSON: `{"btc_staking":{"new_fp":[{"description":{"moniker":"my-fp","identity":"","website":"","security_contact":"","details":""},"commission":"0.050000000000000000","babylon_pk":{"key":"AQI="},"btc_pk_hex":"02ab","consumer_id":"my-chain"}],"active_del":[],"slashed_del":[],"unbonded_del":[]}}`,
+		},
+		"register finality provider without consumer id": {
+			build: func() (contract.BTCStakingExecuteMsg, error) {
+				_, err := parseNewFinalityProvider("02ab", "0.05", NewRegisterFinalityProviderCmd().Flags())
+				return contract.BTCStakingExecuteMsg{}, err
+			},
+			expErr: true,
+		},
+		"commit public randomness": {
+			build: func() (contract.BTCStakingExecuteMsg, error) {
+				msg, err := parseCommitPubRand([]string{"02ab", "100", "1000", "0102", "0304"})
+				return contract.BTCStakingExecuteMsg{CommitPublicRandomness: &msg}, err
+			},
+			expJSON: `{"commit_public_randomness":{"fp_pubkey_hex":"02ab","start_height":100,"num_pub_rand":1000,"commitment":"AQI=","signature":"AwQ="}}`,
+		},
+		"submit finality signature": {
+			build: func() (contract.BTCStakingExecuteMsg, error) {
+				proof := []byte(`{"total":"2","index":"1","leaf_hash":"BQY=","aunts":["Bwg="]}`)
+				msg, err := parseSubmitFinalitySig([]string{"02ab", "120", "0102", "", "0304", "0506"}, proof)
+				return contract.BTCStakingExecuteMsg{SubmitFinalitySignature: &msg}, err
+			},
+			expJSON: `{"submit_finality_signature":{"fp_pubkey_hex":"02ab","height":120,"pub_rand":"AQI=","proof":{"total":"2","index":"1","leaf_hash":"BQY=","aunts":["Bwg="]},"block_hash":"AwQ=","signature":"BQY="}}`,
+		},
+		"invalid height": {
+			build: func() (contract.BTCStakingExecuteMsg, error) {
+				msg, err := parseSubmitFinalitySig([]string{"02ab", "-1", "0102", "", "0304", "0506"}, []byte(`{}`))
+				return contract.BTCStakingExecuteMsg{SubmitFinalitySignature: &msg}, err
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			execMsg, gotErr := spec.build()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// when
+			msg, err := buildExecuteMsg(mySender, myContractAddr, execMsg)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, mySender.String(), msg.Sender)
+			assert.Equal(t, myContractAddr, msg.Contract)
+			assert.JSONEq(t, spec.expJSON, string(msg.Msg))
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package contract
+
+// BabylonQuery is a smart query to the Babylon contract.
+// See https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/contracts/babylon/src/msg/contract.rs
+type BabylonQuery struct {
+	Config *struct{} `json:"config,omitempty"`
+}
+
+// BabylonConfigResponse contains the subset of the Babylon contract config that the module uses
+type BabylonConfigResponse struct {
+	BTCStaking string `json:"btc_staking"`
+}
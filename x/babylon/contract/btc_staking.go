@@ -0,0 +1,167 @@
+package contract
+
+import "encoding/json"
+
+// BTCStakingQuery is a smart query to the BTC staking contract.
+// See https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/contracts/btc-staking/src/msg.rs
+type BTCStakingQuery struct {
+	FinalityProviders *FinalityProvidersQuery `json:"finality_providers,omitempty"`
+	Delegations       *DelegationsQuery       `json:"delegations,omitempty"`
+	ActivatedHeight   *ActivatedHeightQuery   `json:"activated_height,omitempty"`
+	Block             *BlockQuery             `json:"block,omitempty"`
+	Blocks            *BlocksQuery            `json:"blocks,omitempty"`
+}
+
+// FinalityProvidersQuery requests a page of the finality providers ordered by their BTC public key
+type FinalityProvidersQuery struct {
+	StartAfter string `json:"start_after,omitempty"` // StartAfter is the btc_pk_hex of the last finality provider of the previous page
+	Limit      uint32 `json:"limit,omitempty"`       // Limit is the maximum number of finality providers to return
+}
+
+// FinalityProvidersResponse contains a page of finality providers
+type FinalityProvidersResponse struct {
+	Fps []FinalityProviderInfo `json:"fps"`
+}
+
+// FinalityProviderInfo is a finality provider as returned by the BTC staking contract
+type FinalityProviderInfo struct {
+	Description          *FinalityProviderDescription `json:"description,omitempty"`
+	Commission           string                       `json:"commission"`
+	BTCPkHex             string                       `json:"btc_pk_hex"`
+	SlashedBabylonHeight uint64                       `json:"slashed_babylon_height"`
+	SlashedBtcHeight     uint64                       `json:"slashed_btc_height"`
+	ConsumerID           string                       `json:"consumer_id"`
+}
+
+// FinalityProviderDescription is the description of a finality provider
+type FinalityProviderDescription struct {
+	Moniker         string `json:"moniker"`
+	Identity        string `json:"identity"`
+	Website         string `json:"website"`
+	SecurityContact string `json:"security_contact"`
+	Details         string `json:"details"`
+}
+
+// DelegationsQuery requests a page of the BTC delegations ordered by their staking tx hash
+type DelegationsQuery struct {
+	StartAfter string `json:"start_after,omitempty"` // StartAfter is the staking tx hash in hex of the last delegation of the previous page
+	Limit      uint32 `json:"limit,omitempty"`       // Limit is the maximum number of delegations to return
+}
+
+// DelegationsResponse contains a page of BTC delegations
+type DelegationsResponse struct {
+	Delegations []BTCDelegationInfo `json:"delegations"`
+}
+
+// BTCDelegationInfo is a BTC delegation as returned by the BTC staking contract
+type BTCDelegationInfo struct {
+	BTCPkHex         string   `json:"btc_pk_hex"`
+	FpBtcPkList      []string `json:"fp_btc_pk_list"`
+	StartHeight      uint64   `json:"start_height"`
+	EndHeight        uint64   `json:"end_height"`
+	TotalSat         uint64   `json:"total_sat"`
+	StakingTx        []byte   `json:"staking_tx"`
+	SlashingTx       []byte   `json:"slashing_tx"`
+	StakingOutputIdx uint32   `json:"staking_output_idx"`
+	UnbondingTime    uint32   `json:"unbonding_time"`
+	ParamsVersion    uint32   `json:"params_version"`
+}
+
+// ActivatedHeightQuery requests the height at which BTC staking was activated
+type ActivatedHeightQuery struct{}
+
+// ActivatedHeightResponse contains the height at which BTC staking was activated
+type ActivatedHeightResponse struct {
+	Height uint64 `json:"height"`
+}
+
+// BlockQuery requests a block indexed by the BTC staking contract
+type BlockQuery struct {
+	Height uint64 `json:"height"`
+}
+
+// IndexedBlockResponse is a block as indexed by the BTC staking contract
+type IndexedBlockResponse struct {
+	Height    uint64 `json:"height"`
+	AppHash   []byte `json:"app_hash"`
+	Finalized bool   `json:"finalized"`
+}
+
+// BlocksQuery requests a page of the blocks indexed by the BTC staking contract ordered by height
+type BlocksQuery struct {
+	StartAfter *uint64 `json:"start_after,omitempty"` // StartAfter is the height of the last block of the previous page
+	Limit      *uint32 `json:"limit,omitempty"`       // Limit is the maximum number of blocks to return
+	Finalised  *bool   `json:"finalised,omitempty"`   // Finalised filters the blocks by their finalization status
+	Reverse    *bool   `json:"reverse,omitempty"`     // Reverse returns the blocks in descending height order
+}
+
+// BlocksResponse contains a page of indexed blocks
+type BlocksResponse struct {
+	Blocks []IndexedBlockResponse `json:"blocks"`
+}
+
+// BTCStakingExecuteMsg is an execute message to the BTC staking contract.
+// See https://github.com/babylonchain/babylon-contract/blob/v0.7.0-rc.2/contracts/btc-staking/src/msg.rs
+type BTCStakingExecuteMsg struct {
+	BtcStaking              *BtcStakingMsg              `json:"btc_staking,omitempty"`
+	CommitPublicRandomness  *CommitPublicRandomnessMsg  `json:"commit_public_randomness,omitempty"`
+	SubmitFinalitySignature *SubmitFinalitySignatureMsg `json:"submit_finality_signature,omitempty"`
+}
+
+// BtcStakingMsg contains the BTC staking operations to process. Only new finality providers are sent by the module.
+type BtcStakingMsg struct {
+	NewFp       []NewFinalityProvider `json:"new_fp"`
+	ActiveDel   []json.RawMessage     `json:"active_del"`
+	SlashedDel  []json.RawMessage     `json:"slashed_del"`
+	UnbondedDel []json.RawMessage     `json:"unbonded_del"`
+}
+
+// NewFinalityProvider is a finality provider to register with the BTC staking contract
+type NewFinalityProvider struct {
+	Description *FinalityProviderDescription `json:"description,omitempty"`
+	Commission  string                       `json:"commission"`
+	BabylonPk   *PubKey                      `json:"babylon_pk,omitempty"`
+	BTCPkHex    string                       `json:"btc_pk_hex"`
+	Pop         *ProofOfPossession           `json:"pop,omitempty"`
+	ConsumerID  string                       `json:"consumer_id"`
+}
+
+// PubKey is a secp256k1 public key
+type PubKey struct {
+	Key []byte `json:"key"`
+}
+
+// ProofOfPossession proves that the Babylon and BTC keys of a finality provider belong to the same owner
+type ProofOfPossession struct {
+	BtcSigType int32  `json:"btc_sig_type"`
+	BabylonSig []byte `json:"babylon_sig"`
+	BtcSig     []byte `json:"btc_sig"`
+}
+
+// CommitPublicRandomnessMsg commits a list of EOTS public randomness of a finality provider
+type CommitPublicRandomnessMsg struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	StartHeight uint64 `json:"start_height"`
+	NumPubRand  uint64 `json:"num_pub_rand"`
+	Commitment  []byte `json:"commitment"`
+	Signature   []byte `json:"signature"`
+}
+
+// SubmitFinalitySignatureMsg submits the EOTS signature of a finality provider for a block
+type SubmitFinalitySignatureMsg struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	Height      uint64 `json:"height"`
+	PubRand     []byte `json:"pub_rand"`
+	Proof       Proof  `json:"proof"`
+	BlockHash   []byte `json:"block_hash"`
+	Signature   []byte `json:"signature"`
+}
+
+// Proof is the merkle proof of the public randomness against the committed commitment.
+// It has the same JSON encoding as the CometBFT merkle proof.
+type Proof struct {
+	Total    int64    `json:"total,string"`
+	Index    int64    `json:"index,string"`
+	LeafHash []byte   `json:"leaf_hash"`
+	Aunts    [][]byte `json:"aunts"`
+}
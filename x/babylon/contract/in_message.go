@@ -1,11 +1,47 @@
 package contract
 
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
 // CustomMsg is a message sent from a smart contract to the Babylon module
-// TODO: implement
 type CustomMsg struct {
-	Test *TestMsg `json:"test,omitempty"`
+	MintRewards    *MintRewardsMsg    `json:"mint_rewards,omitempty"`
+	BurnSlashed    *BurnSlashedMsg    `json:"burn_slashed,omitempty"`
+	ScheduleTask   *ScheduleTaskMsg   `json:"schedule_task,omitempty"`
+	UnscheduleTask *UnscheduleTaskMsg `json:"unschedule_task,omitempty"`
+}
+
+// MintRewardsMsg mints new tokens as block rewards and sends them to the recipient,
+// e.g. a delegator of a finality provider
+type MintRewardsMsg struct {
+	// Recipient is the bech32 address that receives the minted rewards
+	Recipient string `json:"recipient"`
+	// Amount is the amount of tokens to mint
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// BurnSlashedMsg burns slashed tokens that are held by the sending contract
+type BurnSlashedMsg struct {
+	// Amount is the amount of tokens to burn
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// ScheduleTaskMsg registers a sudo callback to the sending contract at a future block height.
+// An existing task of the contract at the same phase and height is replaced.
+type ScheduleTaskMsg struct {
+	// Phase is the block phase in which the task is executed, either "begin_block" or "end_block"
+	Phase string `json:"phase"`
+	// Height is the block height of the first execution
+	Height uint64 `json:"height"`
+	// Interval is the number of blocks between repeated executions. Zero schedules a one-shot task.
+	Interval uint64 `json:"interval,omitempty"`
+	// Payload is opaque data that is passed back to the contract on execution
+	Payload []byte `json:"payload,omitempty"`
 }
 
-type TestMsg struct {
-	Placeholder string `json:"placeholder,omitempty"`
+// UnscheduleTaskMsg removes a task that the sending contract scheduled before
+type UnscheduleTaskMsg struct {
+	// Phase is the block phase of the task, either "begin_block" or "end_block"
+	Phase string `json:"phase"`
+	// Height is the block height of the next execution of the task
+	Height uint64 `json:"height"`
 }
@@ -1,17 +1,76 @@
 package contract
 
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// BlockInfoVersion is the version of the block information in the BeginBlock and EndBlock
+// sudo messages. Payloads without a version only contain the block and app hashes.
+const BlockInfoVersion = 1
+
 // SudoMsg is a message sent from the Babylon module to a smart contract
 type SudoMsg struct {
-	BeginBlockMsg *BeginBlock `json:"begin_block,omitempty"`
-	EndBlockMsg   *EndBlock   `json:"end_block,omitempty"`
+	BeginBlockMsg    *BeginBlock    `json:"begin_block,omitempty"`
+	EndBlockMsg      *EndBlock      `json:"end_block,omitempty"`
+	ScheduledTaskMsg *ScheduledTask `json:"scheduled_task,omitempty"`
+	ValsetUpdateMsg  *ValsetUpdate  `json:"valset_update,omitempty"`
 }
 
 type BeginBlock struct {
 	HashHex    string `json:"hash_hex"`     // HashHex is the hash of the block in hex
 	AppHashHex string `json:"app_hash_hex"` // AppHashHex is the app hash of the block in hex
+	*BlockInfo
 }
 
 type EndBlock struct {
 	HashHex    string `json:"hash_hex"`     // HashHex is the hash of the block in hex
 	AppHashHex string `json:"app_hash_hex"` // AppHashHex is the app hash of the block in hex
+	*BlockInfo
+}
+
+// BlockInfo is the block information that is sent in addition to the hashes since BlockInfoVersion 1.
+// The fields are appended to the BeginBlock and EndBlock JSON objects. Contracts that reject unknown
+// fields fail on them, so they are only sent to the contracts that opted in via their hook
+// subscription or registration. Otherwise, BlockInfo is nil and the legacy payload is sent.
+type BlockInfo struct {
+	Version             uint32             `json:"version"`                         // Version is the BlockInfoVersion of the payload
+	Height              uint64             `json:"height"`                          // Height is the height of the block
+	Time                wasmvmtypes.Uint64 `json:"time"`                            // Time is the block time in nanoseconds since the UNIX epoch
+	ChainID             string             `json:"chain_id"`                        // ChainID is the ID of the chain
+	ProposerConsAddress string             `json:"proposer_cons_address,omitempty"` // ProposerConsAddress is the bech32 consensus address of the block proposer
+	LastCommit          *LastCommitInfo    `json:"last_commit,omitempty"`           // LastCommit is the commit information of the previous block
+}
+
+// LastCommitInfo is the commit information of the previous block
+type LastCommitInfo struct {
+	Round int32      `json:"round"` // Round is the consensus round of the commit
+	Votes []VoteInfo `json:"votes"` // Votes are the votes of the validators of the previous block
+}
+
+// VoteInfo is the vote of a validator in the last commit
+type VoteInfo struct {
+	ConsAddress string `json:"cons_address"` // ConsAddress is the bech32 consensus address of the validator
+	Power       int64  `json:"power"`        // Power is the voting power of the validator
+	Signed      bool   `json:"signed"`       // Signed is true when the validator signed the previous block
+}
+
+// ScheduledTask is sent to a contract when a task that it scheduled is due
+type ScheduledTask struct {
+	Phase   string `json:"phase"`             // Phase is the block phase of the execution, "begin_block" or "end_block"
+	Height  uint64 `json:"height"`            // Height is the block height the task was scheduled at
+	Payload []byte `json:"payload,omitempty"` // Payload is the data given when the task was scheduled
+}
+
+// ValsetUpdate is sent to the BTC staking contract when the consumer validator set changed since
+// the last update. Validators are identified by their bech32 operator address.
+type ValsetUpdate struct {
+	Additions    []Validator `json:"additions"`     // Additions are the validators that joined the active set
+	Removals     []string    `json:"removals"`      // Removals are the validators that left the active set for any reason
+	PowerChanged []Validator `json:"power_changed"` // PowerChanged are the active validators with a new power
+	Jailed       []string    `json:"jailed"`        // Jailed are the validators that were jailed
+	Tombstoned   []string    `json:"tombstoned"`    // Tombstoned are the validators that were tombstoned
+}
+
+// IsEmpty returns true when the update has no changes
+func (u ValsetUpdate) IsEmpty() bool {
+	return len(u.Additions) == 0 && len(u.Removals) == 0 && len(u.PowerChanged) == 0 &&
+		len(u.Jailed) == 0 && len(u.Tombstoned) == 0
 }
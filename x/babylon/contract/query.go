@@ -1,16 +1,69 @@
 package contract
 
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
 // CustomQuery is a query request from a smart contract to the Babylon module
-// TODO: implement
 type CustomQuery struct {
-	Test *TestQuery `json:"test,omitempty"`
+	Params            *ParamsQuery            `json:"params,omitempty"`
+	ContractAddresses *ContractAddressesQuery `json:"contract_addresses,omitempty"`
+	BlockHeader       *BlockHeaderQuery       `json:"block_header,omitempty"`
+	ValidatorSet      *ValidatorSetQuery      `json:"validator_set,omitempty"`
+}
+
+// ParamsQuery requests the current parameters of the Babylon module
+type ParamsQuery struct{}
+
+// ParamsResponse contains the current parameters of the Babylon module
+type ParamsResponse struct {
+	// MaxGasBeginBlocker is the gas limit of the sudo call at BeginBlock
+	MaxGasBeginBlocker uint32 `json:"max_gas_begin_blocker"`
+	// MaxGasEndBlocker is the gas limit of the sudo call at EndBlock
+	MaxGasEndBlocker uint32 `json:"max_gas_end_blocker"`
+	// HaltOnHookFailure is set when a failed sudo call halts the chain
+	HaltOnHookFailure bool `json:"halt_on_hook_failure"`
+	// MaxConsecutiveHookFailures is the threshold of the hook circuit breaker
+	MaxConsecutiveHookFailures uint32 `json:"max_consecutive_hook_failures"`
+}
+
+// ContractAddressesQuery requests the contract addresses configured in the Babylon module
+type ContractAddressesQuery struct{}
+
+// ContractAddressesResponse contains the contract addresses configured in the Babylon module
+type ContractAddressesResponse struct {
+	// BabylonContract is the bech32 address of the Babylon contract, empty when not set
+	BabylonContract string `json:"babylon_contract"`
+	// BtcStakingContract is the bech32 address of the BTC staking contract, empty when not set
+	BtcStakingContract string `json:"btc_staking_contract"`
+}
+
+// BlockHeaderQuery requests the header information of the consumer chain block at the given height
+type BlockHeaderQuery struct {
+	Height uint64 `json:"height"`
 }
 
-type TestQuery struct {
-	Placeholder string `json:"placeholder,omitempty"`
+// BlockHeaderResponse contains the header information of a consumer chain block
+type BlockHeaderResponse struct {
+	Height     uint64 `json:"height"`
+	HashHex    string `json:"hash_hex"`     // HashHex is the hash of the block in hex
+	AppHashHex string `json:"app_hash_hex"` // AppHashHex is the app hash of the block in hex
+	// Time is the block time in nanoseconds since the UNIX epoch
+	Time wasmvmtypes.Uint64 `json:"time"`
+}
+
+// ValidatorSetQuery requests the active validator set of the consumer chain
+type ValidatorSetQuery struct{}
+
+// ValidatorSetResponse contains the active validator set of the consumer chain, ordered by power
+type ValidatorSetResponse struct {
+	Validators []Validator `json:"validators"`
 }
 
-type TestResponse struct {
-	// MaxCap is the max cap limit
-	Placeholder2 string `json:"placeholder2"`
+// Validator is a member of the consumer chain's validator set
+type Validator struct {
+	// Address is the bech32 validator operator address
+	Address string `json:"address"`
+	// ConsAddress is the bech32 consensus address of the validator
+	ConsAddress string `json:"cons_address"`
+	// Power is the consensus power of the validator
+	Power int64 `json:"power"`
 }
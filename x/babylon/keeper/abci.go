@@ -12,7 +12,15 @@ import (
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
-	return k.SendBeginBlockMsg(ctx)
+	if err := k.IndexHeader(ctx); err != nil {
+		return err
+	}
+
+	if err := k.SendBeginBlockMsg(ctx); err != nil {
+		return err
+	}
+
+	return k.ExecScheduledTasks(ctx, types.SchedulerPhaseBeginBlock)
 }
 
 // EndBlocker is called after every block
@@ -22,6 +30,12 @@ func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error)
 	if err := k.SendEndBlockMsg(ctx); err != nil {
 		return []abci.ValidatorUpdate{}, err
 	}
+	if err := k.SendValsetUpdate(ctx); err != nil {
+		return []abci.ValidatorUpdate{}, err
+	}
+	if err := k.ExecScheduledTasks(ctx, types.SchedulerPhaseEndBlock); err != nil {
+		return []abci.ValidatorUpdate{}, err
+	}
 
 	return []abci.ValidatorUpdate{}, nil
 }
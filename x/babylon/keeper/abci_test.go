@@ -1 +1,556 @@
 package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/comet"
+	coreheader "cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestSendBlockMsgFailureIsolation(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myKey, myValue := []byte("my-key"), []byte("my-value")
+
+	specs := map[string]struct {
+		gasConsumed   uint64
+		sudoErr       error
+		sudoPanic     bool
+		haltOnFailure bool
+		expErr        error
+		expSuccess    bool
+	}{
+		"within gas limit": {
+			gasConsumed: 100_000,
+			expSuccess:  true,
+		},
+		"exceeds gas limit": {
+			gasConsumed: 600_000,
+		},
+		"exceeds gas limit, halt on failure": {
+			gasConsumed:   600_000,
+			haltOnFailure: true,
+			expErr:        sdkerrors.ErrOutOfGas,
+		},
+		"contract error": {
+			gasConsumed: 100_000,
+			sudoErr:     types.ErrInvalid,
+		},
+		"contract error, halt on failure": {
+			gasConsumed:   100_000,
+			sudoErr:       types.ErrInvalid,
+			haltOnFailure: true,
+			expErr:        types.ErrInvalid,
+		},
+		"contract panics": {
+			gasConsumed: 100_000,
+			sudoPanic:   true,
+		},
+		"contract panics, halt on failure": {
+			gasConsumed:   100_000,
+			sudoPanic:     true,
+			haltOnFailure: true,
+			expErr:        types.ErrHookFailed,
+		},
+	}
+	for name, spec := range specs {
+		for _, hook := range []string{types.SudoHookBeginBlock, types.SudoHookEndBlock} {
+			t.Run(name+" "+hook, func(t *testing.T) {
+				var storeKey storetypes.StoreKey
+				mock := MockWasmKeeper{
+					HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+						return contractAddress.Equals(myContractAddr)
+					},
+					SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+						sdkCtx := sdk.UnwrapSDKContext(ctx)
+						sdkCtx.KVStore(storeKey).Set(myKey, myValue)
+						sdkCtx.GasMeter().ConsumeGas(spec.gasConsumed, "testing")
+						if spec.sudoPanic {
+							panic("testing")
+						}
+						return nil, spec.sudoErr
+					},
+				}
+				keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+				storeKey = keepers.StoreKey
+				k := keepers.BabylonKeeper
+
+				params := types.DefaultParams(sdk.DefaultBondDenom)
+				params.BtcStakingContractAddress = myContractAddr.String()
+				params.HaltOnHookFailure = spec.haltOnFailure
+				require.NoError(t, k.SetParams(keepers.Ctx, params))
+				ctx := keepers.Ctx.WithEventManager(sdk.NewEventManager())
+
+				// when
+				var gotErr error
+				if hook == types.SudoHookBeginBlock {
+					gotErr = k.BeginBlocker(ctx)
+				} else {
+					_, gotErr = k.EndBlocker(ctx)
+				}
+
+				// then
+				if spec.expErr != nil {
+					require.ErrorIs(t, gotErr, spec.expErr)
+				} else {
+					require.NoError(t, gotErr)
+				}
+				assert.Equal(t, spec.expSuccess, ctx.KVStore(storeKey).Has(myKey))
+
+				var gasEventFound, execEventFound bool
+				for _, e := range ctx.EventManager().Events() {
+					switch e.Type {
+					case types.EventTypeSudoGasUsed:
+						gasEventFound = true
+						attr, ok := e.GetAttribute(types.AttributeKeySudoHook)
+						require.True(t, ok)
+						assert.Equal(t, hook, attr.Value)
+					case types.EventTypeHookExec:
+						execEventFound = true
+						attr, ok := e.GetAttribute(types.AttributeKeyHookExecSuccess)
+						require.True(t, ok)
+						assert.Equal(t, fmt.Sprintf("%t", spec.expSuccess), attr.Value)
+						_, ok = e.GetAttribute(types.AttributeKeyHookExecError)
+						assert.Equal(t, !spec.expSuccess, ok)
+						attr, ok = e.GetAttribute(types.AttributeKeyContractAddress)
+						require.True(t, ok)
+						assert.Equal(t, myContractAddr.String(), attr.Value)
+					}
+				}
+				assert.True(t, gasEventFound)
+				assert.True(t, execEventFound)
+			})
+		}
+	}
+}
+
+var _ types.WasmKeeper = &MockWasmKeeper{}
+
+type MockWasmKeeper struct {
+	SudoFn            func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+	HasContractInfoFn func(ctx context.Context, contractAddress sdk.AccAddress) bool
+	QuerySmartFn      func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	GetContractInfoFn func(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	GetCodeInfoFn     func(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo
+}
+
+func (m MockWasmKeeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if m.SudoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SudoFn(ctx, contractAddress, msg)
+}
+
+func (m MockWasmKeeper) HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	if m.HasContractInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.HasContractInfoFn(ctx, contractAddress)
+}
+
+func (m MockWasmKeeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if m.QuerySmartFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QuerySmartFn(ctx, contractAddr, req)
+}
+
+func (m MockWasmKeeper) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	if m.GetContractInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetContractInfoFn(ctx, contractAddress)
+}
+
+func (m MockWasmKeeper) GetCodeInfo(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo {
+	if m.GetCodeInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetCodeInfoFn(ctx, codeID)
+}
+
+func TestSendBlockMsgCircuitBreaker(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	var sudoCalls int
+	var sudoErr error
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			sudoCalls++
+			return nil, sudoErr
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.MaxConsecutiveHookFailures = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a success resets the failure counter
+	sudoErr = types.ErrInvalid
+	require.NoError(t, k.BeginBlocker(ctx))
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.HookStatus{ConsecutiveFailures: 2}, k.GetHookStatus(ctx, myContractAddr))
+	sudoErr = nil
+	require.NoError(t, k.BeginBlocker(ctx))
+	assert.Equal(t, types.HookStatus{}, k.GetHookStatus(ctx, myContractAddr))
+
+	// reaching the threshold suspends the hooks
+	sudoErr = types.ErrInvalid
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.BeginBlocker(ctx))
+	}
+	assert.Equal(t, types.HookStatus{ConsecutiveFailures: 3, Suspended: true, SuspendedAtHeight: ctx.BlockHeight()}, k.GetHookStatus(ctx, myContractAddr))
+	assert.True(t, k.IsHookSuspended(ctx, myContractAddr))
+
+	// suspended contracts are not called anymore
+	sudoCalls = 0
+	require.NoError(t, k.BeginBlocker(ctx))
+	_, err = k.EndBlocker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sudoCalls)
+
+	// resuming clears the status
+	k.ResumeHooks(ctx, myContractAddr)
+	assert.False(t, k.IsHookSuspended(ctx, myContractAddr))
+	sudoErr = nil
+	require.NoError(t, k.BeginBlocker(ctx))
+	assert.Equal(t, 1, sudoCalls)
+}
+
+func TestSendBlockMsgSeparateGasLimits(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(200_000, "testing")
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.HaltOnHookFailure = true
+	params.MaxGasBeginBlocker = 100_000
+	params.MaxGasEndBlocker = 300_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.ErrorIs(t, k.BeginBlocker(ctx), sdkerrors.ErrOutOfGas)
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+}
+
+func TestSendBlockMsgStakingMsgRejected(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			// the rejection event of the message handler is emitted in the branched context only
+			types.EmitStakingMsgRejectedEvent(ctx, contractAddress.String(), "staking")
+			return nil, errorsmod.Wrap(&types.StakingMsgRejectedError{ContractAddress: contractAddress.String(), MsgType: "staking"}, "dispatch")
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(keepers.Ctx, params))
+	ctx := keepers.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// when
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// then
+	var found int
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.EventTypeStakingMsgRejected {
+			continue
+		}
+		found++
+		attr, ok := e.GetAttribute(types.AttributeKeyContractAddress)
+		require.True(t, ok)
+		assert.Equal(t, myContractAddr.String(), attr.Value)
+		attr, ok = e.GetAttribute(types.AttributeKeyMsgType)
+		require.True(t, ok)
+		assert.Equal(t, "staking", attr.Value)
+	}
+	assert.Equal(t, 1, found)
+}
+
+func TestBeginBlockerIndexesHeader(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithHeaderInfo(coreheader.Info{
+		Height:  100,
+		Hash:    []byte{0x1},
+		AppHash: []byte{0x2},
+		Time:    keepers.Ctx.BlockTime(),
+	})
+
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	header, found := k.GetIndexedHeader(ctx, 100)
+	require.True(t, found)
+	assert.Equal(t, types.IndexedHeader{Height: 100, Hash: []byte{0x1}, AppHash: []byte{0x2}, Time: keepers.Ctx.BlockTime()}, header)
+	_, found = k.GetIndexedHeader(ctx, 99)
+	assert.False(t, found)
+}
+
+func TestSendBlockMsgPayload(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myProposer := rand.Bytes(20)
+	myVoter, myAbsentVoter := rand.Bytes(20), rand.Bytes(20)
+	myTime := time.Unix(1_700_000_000, 1).UTC()
+
+	var gotMsgs [][]byte
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			gotMsgs = append(gotMsgs, msg)
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithHeaderInfo(coreheader.Info{
+		Height:  100,
+		Hash:    []byte{0x1},
+		AppHash: []byte{0x2},
+		Time:    myTime,
+		ChainID: "my-chain",
+	}).WithCometInfo(mockCometInfo{
+		proposer: myProposer,
+		round:    1,
+		votes: []mockVoteInfo{
+			{address: myVoter, power: 10, flag: comet.BlockIDFlagCommit},
+			{address: myAbsentVoter, power: 5, flag: comet.BlockIDFlagAbsent},
+		},
+	})
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.HookSubscriptions = []types.HookSubscription{
+		{Contract: types.ContractBTCStaking, BeginBlock: true, EndBlock: true, BlockInfo: true},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// when
+	require.NoError(t, k.BeginBlocker(ctx))
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, gotMsgs, 2)
+	expBlockInfo := &contract.BlockInfo{
+		Version:             contract.BlockInfoVersion,
+		Height:              100,
+		Time:                wasmvmtypes.Uint64(myTime.UnixNano()),
+		ChainID:             "my-chain",
+		ProposerConsAddress: sdk.ConsAddress(myProposer).String(),
+		LastCommit: &contract.LastCommitInfo{
+			Round: 1,
+			Votes: []contract.VoteInfo{
+				{ConsAddress: sdk.ConsAddress(myVoter).String(), Power: 10, Signed: true},
+				{ConsAddress: sdk.ConsAddress(myAbsentVoter).String(), Power: 5, Signed: false},
+			},
+		},
+	}
+	var gotBegin contract.SudoMsg
+	require.NoError(t, json.Unmarshal(gotMsgs[0], &gotBegin))
+	assert.Equal(t, &contract.BeginBlock{HashHex: "01", AppHashHex: "02", BlockInfo: expBlockInfo}, gotBegin.BeginBlockMsg)
+	var gotEnd contract.SudoMsg
+	require.NoError(t, json.Unmarshal(gotMsgs[1], &gotEnd))
+	assert.Equal(t, &contract.EndBlock{HashHex: "01", AppHashHex: "02", BlockInfo: expBlockInfo}, gotEnd.EndBlockMsg)
+
+	// and the block info fields are appended to the legacy payload
+	var gotRaw map[string]map[string]any
+	require.NoError(t, json.Unmarshal(gotMsgs[0], &gotRaw))
+	assert.Equal(t, "01", gotRaw[types.SudoHookBeginBlock]["hash_hex"])
+	assert.Equal(t, "02", gotRaw[types.SudoHookBeginBlock]["app_hash_hex"])
+	assert.Equal(t, float64(contract.BlockInfoVersion), gotRaw[types.SudoHookBeginBlock]["version"])
+}
+
+func TestSendBlockMsgLegacyPayload(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myRegisteredContractAddr := sdk.AccAddress(rand.Bytes(32))
+
+	gotMsgs := make(map[string][]string)
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			gotMsgs[contractAddress.String()] = append(gotMsgs[contractAddress.String()], string(msg))
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: 100, Hash: []byte{0x1}, AppHash: []byte{0x2}, ChainID: "my-chain"})
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetHookRegistration(ctx, types.HookRegistration{
+		ContractAddress: myRegisteredContractAddr.String(),
+		Hook:            types.SudoHookBeginBlock,
+		BlockInfo:       true,
+	}))
+
+	// when
+	require.NoError(t, k.BeginBlocker(ctx))
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// then contracts that did not opt in receive the hashes only
+	assert.Equal(t, []string{
+		`{"begin_block":{"hash_hex":"01","app_hash_hex":"02"}}`,
+		`{"end_block":{"hash_hex":"01","app_hash_hex":"02"}}`,
+	}, gotMsgs[myContractAddr.String()])
+	// and a registration can opt into the block info
+	require.Len(t, gotMsgs[myRegisteredContractAddr.String()], 1)
+	var gotBegin contract.SudoMsg
+	require.NoError(t, json.Unmarshal([]byte(gotMsgs[myRegisteredContractAddr.String()][0]), &gotBegin))
+	require.NotNil(t, gotBegin.BeginBlockMsg.BlockInfo)
+	assert.Equal(t, uint32(contract.BlockInfoVersion), gotBegin.BeginBlockMsg.Version)
+	assert.Equal(t, uint64(100), gotBegin.BeginBlockMsg.Height)
+}
+
+type mockCometInfo struct {
+	proposer []byte
+	round    int32
+	votes    []mockVoteInfo
+}
+
+func (m mockCometInfo) GetEvidence() comet.EvidenceList { return nil }
+func (m mockCometInfo) GetValidatorsHash() []byte       { return nil }
+func (m mockCometInfo) GetProposerAddress() []byte      { return m.proposer }
+func (m mockCometInfo) GetLastCommit() comet.CommitInfo { return m }
+func (m mockCometInfo) Round() int32                    { return m.round }
+func (m mockCometInfo) Votes() comet.VoteInfos          { return m }
+func (m mockCometInfo) Len() int                        { return len(m.votes) }
+func (m mockCometInfo) Get(i int) comet.VoteInfo        { return m.votes[i] }
+
+type mockVoteInfo struct {
+	address []byte
+	power   int64
+	flag    comet.BlockIDFlag
+}
+
+func (m mockVoteInfo) Validator() comet.Validator        { return m }
+func (m mockVoteInfo) GetBlockIDFlag() comet.BlockIDFlag { return m.flag }
+func (m mockVoteInfo) Address() []byte                   { return m.address }
+func (m mockVoteInfo) Power() int64                      { return m.power }
+
+func TestSendBlockMsgHookSubscriptions(t *testing.T) {
+	myBabylonAddr := sdk.AccAddress(rand.Bytes(32))
+	myBTCStakingAddr := sdk.AccAddress(rand.Bytes(32))
+	type call struct {
+		contract sdk.AccAddress
+		hook     string
+		gasLimit storetypes.Gas
+	}
+
+	specs := map[string]struct {
+		subscriptions []types.HookSubscription
+		expCalls      []call
+	}{
+		"default subscription": {
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"subscriptions in order with custom gas": {
+			subscriptions: []types.HookSubscription{
+				{Contract: types.ContractBabylon, BeginBlock: true, EndBlock: true, GasLimit: 50_000},
+				{Contract: types.ContractBTCStaking, EndBlock: true},
+			},
+			expCalls: []call{
+				{contract: myBabylonAddr, hook: types.SudoHookBeginBlock, gasLimit: 50_000},
+				{contract: myBabylonAddr, hook: types.SudoHookEndBlock, gasLimit: 50_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"btc staking before babylon": {
+			subscriptions: []types.HookSubscription{
+				{Contract: types.ContractBTCStaking, BeginBlock: true},
+				{Contract: types.ContractBabylon, BeginBlock: true},
+			},
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBabylonAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotCalls []call
+			mock := MockWasmKeeper{
+				HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+					return true
+				},
+				SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					var sudoMsg contract.SudoMsg
+					require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+					hook := types.SudoHookBeginBlock
+					if sudoMsg.EndBlockMsg != nil {
+						hook = types.SudoHookEndBlock
+					}
+					gasLimit := sdk.UnwrapSDKContext(ctx).GasMeter().Limit()
+					gotCalls = append(gotCalls, call{contract: contractAddress, hook: hook, gasLimit: gasLimit})
+					return nil, nil
+				},
+			}
+			keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx
+
+			params := types.DefaultParams(sdk.DefaultBondDenom)
+			params.BabylonContractAddress = myBabylonAddr.String()
+			params.BtcStakingContractAddress = myBTCStakingAddr.String()
+			params.MaxGasBeginBlocker = 100_000
+			params.MaxGasEndBlocker = 200_000
+			params.HookSubscriptions = spec.subscriptions
+			require.NoError(t, k.SetParams(ctx, params))
+
+			// when
+			require.NoError(t, k.BeginBlocker(ctx))
+			_, err := k.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expCalls, gotCalls)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// SetContractAuthorization stores the authorization of a contract to dispatch custom messages.
+// An existing authorization of the same contract is replaced.
+func (k Keeper) SetContractAuthorization(ctx context.Context, auth types.ContractAuthorization) error {
+	if err := auth.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(auth.ContractAddress)
+	return k.ContractAuthorizations.Set(ctx, contractAddr, auth)
+}
+
+// GetContractAuthorization returns the authorization of the given contract
+func (k Keeper) GetContractAuthorization(ctx context.Context, contractAddr sdk.AccAddress) (types.ContractAuthorization, bool) {
+	auth, err := k.ContractAuthorizations.Get(ctx, contractAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return auth, false
+	case err != nil:
+		panic(err)
+	}
+	return auth, true
+}
+
+// RemoveContractAuthorization deletes the authorization of the given contract
+func (k Keeper) RemoveContractAuthorization(ctx context.Context, contractAddr sdk.AccAddress) error {
+	return k.ContractAuthorizations.Remove(ctx, contractAddr)
+}
+
+// IterateContractAuthorizations iterates over all contract authorizations in the order of the contract addresses.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateContractAuthorizations(ctx context.Context, cb func(auth types.ContractAuthorization) bool) {
+	err := k.ContractAuthorizations.Walk(ctx, nil, func(_ sdk.AccAddress, auth types.ContractAuthorization) (bool, error) {
+		return cb(auth), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsContractAuthorized returns true when the contract is permitted to dispatch the custom message type.
+// The Babylon and BTC staking contracts configured in the params are authorized for all types.
+func (k Keeper) IsContractAuthorized(ctx context.Context, contractAddr sdk.AccAddress, msgType string) bool {
+	params := k.GetParams(ctx)
+	addrStr := contractAddr.String()
+	if addrStr == params.BabylonContractAddress || addrStr == params.BtcStakingContractAddress {
+		return true
+	}
+	auth, found := k.GetContractAuthorization(ctx, contractAddr)
+	return found && slices.Contains(auth.MsgTypes, msgType)
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// queryBTCStakingContract sends the smart query to the BTC staking contract configured in the params
+// and decodes the JSON response into rsp
+func (k Keeper) queryBTCStakingContract(ctx context.Context, req contract.BTCStakingQuery, rsp any) error {
+	addrStr := k.GetParams(ctx).BtcStakingContractAddress
+	if len(addrStr) == 0 {
+		return types.ErrNotFound.Wrap("BTC staking contract address not set")
+	}
+	addr, err := sdk.AccAddressFromBech32(addrStr)
+	if err != nil {
+		return types.ErrInvalid.Wrapf("BTC staking contract address: %s", err)
+	}
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal smart query")
+	}
+	res, err := k.wasm.QuerySmart(ctx, addr, bz)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res, rsp)
+}
+
+// GetFinalityProviders returns a page of the finality providers of the BTC staking contract
+func (k Keeper) GetFinalityProviders(ctx context.Context, startAfter string, limit uint32) ([]types.FinalityProvider, error) {
+	var rsp contract.FinalityProvidersResponse
+	req := contract.BTCStakingQuery{FinalityProviders: &contract.FinalityProvidersQuery{StartAfter: startAfter, Limit: limit}}
+	if err := k.queryBTCStakingContract(ctx, req, &rsp); err != nil {
+		return nil, err
+	}
+	result := make([]types.FinalityProvider, len(rsp.Fps))
+	for i, fp := range rsp.Fps {
+		result[i] = types.FinalityProvider{
+			BtcPkHex:             fp.BTCPkHex,
+			Commission:           fp.Commission,
+			ConsumerId:           fp.ConsumerID,
+			SlashedBabylonHeight: fp.SlashedBabylonHeight,
+			SlashedBtcHeight:     fp.SlashedBtcHeight,
+		}
+		if d := fp.Description; d != nil {
+			result[i].Description = &types.FinalityProviderDescription{
+				Moniker:         d.Moniker,
+				Identity:        d.Identity,
+				Website:         d.Website,
+				SecurityContact: d.SecurityContact,
+				Details:         d.Details,
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetBTCDelegations returns a page of the BTC delegations of the BTC staking contract
+func (k Keeper) GetBTCDelegations(ctx context.Context, startAfter string, limit uint32) ([]types.BTCDelegation, error) {
+	var rsp contract.DelegationsResponse
+	req := contract.BTCStakingQuery{Delegations: &contract.DelegationsQuery{StartAfter: startAfter, Limit: limit}}
+	if err := k.queryBTCStakingContract(ctx, req, &rsp); err != nil {
+		return nil, err
+	}
+	result := make([]types.BTCDelegation, len(rsp.Delegations))
+	for i, d := range rsp.Delegations {
+		result[i] = types.BTCDelegation{
+			StakingTxHashHex: btcTxHashHex(d.StakingTx),
+			BtcPkHex:         d.BTCPkHex,
+			FpBtcPkList:      d.FpBtcPkList,
+			StartHeight:      d.StartHeight,
+			EndHeight:        d.EndHeight,
+			TotalSat:         d.TotalSat,
+			StakingTx:        d.StakingTx,
+			SlashingTx:       d.SlashingTx,
+			StakingOutputIdx: d.StakingOutputIdx,
+			UnbondingTime:    d.UnbondingTime,
+			ParamsVersion:    d.ParamsVersion,
+		}
+	}
+	return result, nil
+}
+
+// GetActivatedHeight returns the height at which BTC staking was activated in the BTC staking contract
+func (k Keeper) GetActivatedHeight(ctx context.Context) (uint64, error) {
+	var rsp contract.ActivatedHeightResponse
+	if err := k.queryBTCStakingContract(ctx, contract.BTCStakingQuery{ActivatedHeight: &contract.ActivatedHeightQuery{}}, &rsp); err != nil {
+		return 0, err
+	}
+	return rsp.Height, nil
+}
+
+// GetIndexedBlock returns the block at the given height as indexed by the BTC staking contract
+func (k Keeper) GetIndexedBlock(ctx context.Context, height uint64) (types.IndexedBlock, error) {
+	var rsp contract.IndexedBlockResponse
+	if err := k.queryBTCStakingContract(ctx, contract.BTCStakingQuery{Block: &contract.BlockQuery{Height: height}}, &rsp); err != nil {
+		return types.IndexedBlock{}, err
+	}
+	return types.IndexedBlock{Height: rsp.Height, AppHash: rsp.AppHash, Finalized: rsp.Finalized}, nil
+}
+
+// GetIndexedBlocks returns a page of the blocks indexed by the BTC staking contract. A zero startAfter
+// starts at the first block in the requested order.
+func (k Keeper) GetIndexedBlocks(ctx context.Context, startAfter uint64, limit uint32, finalizedOnly, reverse bool) ([]types.IndexedBlock, error) {
+	q := contract.BlocksQuery{Limit: &limit}
+	if startAfter != 0 {
+		q.StartAfter = &startAfter
+	}
+	if finalizedOnly {
+		q.Finalised = &finalizedOnly
+	}
+	if reverse {
+		q.Reverse = &reverse
+	}
+	var rsp contract.BlocksResponse
+	if err := k.queryBTCStakingContract(ctx, contract.BTCStakingQuery{Blocks: &q}, &rsp); err != nil {
+		return nil, err
+	}
+	result := make([]types.IndexedBlock, len(rsp.Blocks))
+	for i, b := range rsp.Blocks {
+		result[i] = types.IndexedBlock{Height: b.Height, AppHash: b.AppHash, Finalized: b.Finalized}
+	}
+	return result, nil
+}
+
+// GetBlockStatus returns the block at the given height and whether it is indexed by the BTC staking contract.
+// Unlike GetIndexedBlock, a block that is not indexed is not an error.
+func (k Keeper) GetBlockStatus(ctx context.Context, height uint64) (types.IndexedBlock, bool, error) {
+	if height == 0 {
+		return types.IndexedBlock{}, false, nil
+	}
+	blocks, err := k.GetIndexedBlocks(ctx, height-1, 1, false, false)
+	if err != nil {
+		return types.IndexedBlock{}, false, err
+	}
+	if len(blocks) == 0 || blocks[0].Height != height {
+		return types.IndexedBlock{Height: height}, false, nil
+	}
+	return blocks[0], true, nil
+}
+
+// GetLatestFinalizedHeight returns the height of the latest BTC-finalized block or zero when no block is
+// finalized yet
+func (k Keeper) GetLatestFinalizedHeight(ctx context.Context) (uint64, error) {
+	blocks, err := k.GetIndexedBlocks(ctx, 0, 1, true, true)
+	if err != nil || len(blocks) == 0 {
+		return 0, err
+	}
+	return blocks[0].Height, nil
+}
+
+// btcTxHashHex returns the Bitcoin tx hash of the serialized transaction in the byte-reversed hex
+// notation that is used as key by the BTC staking contract. Staking transactions carry no witness,
+// so the hash equals the txid.
+func btcTxHashHex(tx []byte) string {
+	first := sha256.Sum256(tx)
+	hash := sha256.Sum256(first[:])
+	slices.Reverse(hash[:])
+	return hex.EncodeToString(hash[:])
+}
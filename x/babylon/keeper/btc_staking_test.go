@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestQueryFinalityProviders(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	fps := []contract.FinalityProviderInfo{
+		{BTCPkHex: "aa", Commission: "0.1", ConsumerID: "consumer", Description: &contract.FinalityProviderDescription{Moniker: "one"}},
+		{BTCPkHex: "bb", Commission: "0.2", ConsumerID: "consumer", SlashedBabylonHeight: 10, SlashedBtcHeight: 20},
+	}
+
+	specs := map[string]struct {
+		pagination    *query.PageRequest
+		contractSet   bool
+		queryErr      error
+		expQuery      contract.FinalityProvidersQuery
+		expCode       codes.Code
+		expNextKey    []byte
+		expResultSize int
+	}{
+		"default page": {
+			contractSet:   true,
+			expQuery:      contract.FinalityProvidersQuery{Limit: 10},
+			expResultSize: 2,
+		},
+		"full page with next key": {
+			pagination:    &query.PageRequest{Key: []byte("00"), Limit: 2},
+			contractSet:   true,
+			expQuery:      contract.FinalityProvidersQuery{StartAfter: "00", Limit: 2},
+			expNextKey:    []byte("bb"),
+			expResultSize: 2,
+		},
+		"offset pagination": {
+			pagination:  &query.PageRequest{Offset: 1},
+			contractSet: true,
+			expCode:     codes.InvalidArgument,
+		},
+		"count total": {
+			pagination:  &query.PageRequest{CountTotal: true},
+			contractSet: true,
+			expCode:     codes.InvalidArgument,
+		},
+		"contract not set": {
+			expCode: codes.FailedPrecondition,
+		},
+		"contract query fails": {
+			contractSet: true,
+			queryErr:    errors.New("testing"),
+			expCode:     codes.Internal,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotQuery contract.BTCStakingQuery
+			mock := MockWasmKeeper{
+				QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+					require.Equal(t, myContractAddr, contractAddr)
+					require.NoError(t, json.Unmarshal(req, &gotQuery))
+					if spec.queryErr != nil {
+						return nil, spec.queryErr
+					}
+					return json.Marshal(contract.FinalityProvidersResponse{Fps: fps})
+				},
+			}
+			keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx
+			if spec.contractSet {
+				params := k.GetParams(ctx)
+				params.BtcStakingContractAddress = myContractAddr.String()
+				require.NoError(t, k.SetParams(ctx, params))
+			}
+			q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+			// when
+			rsp, gotErr := q.FinalityProviders(ctx, &types.QueryFinalityProvidersRequest{Pagination: spec.pagination})
+
+			// then
+			if spec.expCode != codes.OK {
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotQuery.FinalityProviders)
+			assert.Equal(t, spec.expQuery, *gotQuery.FinalityProviders)
+			require.Len(t, rsp.FinalityProviders, spec.expResultSize)
+			assert.Equal(t, "aa", rsp.FinalityProviders[0].BtcPkHex)
+			assert.Equal(t, "consumer", rsp.FinalityProviders[0].ConsumerId)
+			assert.Equal(t, "one", rsp.FinalityProviders[0].Description.Moniker)
+			assert.Equal(t, uint64(20), rsp.FinalityProviders[1].SlashedBtcHeight)
+			assert.Nil(t, rsp.FinalityProviders[1].Description)
+			assert.Equal(t, spec.expNextKey, rsp.Pagination.NextKey)
+		})
+	}
+}
+
+func TestQueryFinalityProvidersContractSchema(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			// response of the BTC staking contract version of tests/testdata/version.txt
+			return []byte(`{"fps":[{"description":null,"commission":"0.1","btc_pk_hex":"aa","slashed_babylon_height":0,"slashed_btc_height":0,"consumer_id":"my-consumer"}]}`), nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	params := k.GetParams(keepers.Ctx)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(keepers.Ctx, params))
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+	// when
+	rsp, err := q.FinalityProviders(keepers.Ctx, &types.QueryFinalityProvidersRequest{})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, rsp.FinalityProviders, 1)
+	assert.Equal(t, "my-consumer", rsp.FinalityProviders[0].ConsumerId)
+}
+
+func TestQueryDelegations(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			var q contract.BTCStakingQuery
+			require.NoError(t, json.Unmarshal(req, &q))
+			require.NotNil(t, q.Delegations)
+			assert.Equal(t, contract.DelegationsQuery{Limit: 1}, *q.Delegations)
+			return json.Marshal(contract.DelegationsResponse{Delegations: []contract.BTCDelegationInfo{
+				{BTCPkHex: "aa", FpBtcPkList: []string{"bb"}, StartHeight: 1, EndHeight: 2, TotalSat: 100, StakingTx: []byte{1, 2, 3}},
+			}})
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	params := k.GetParams(ctx)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+	// when
+	rsp, err := q.Delegations(ctx, &types.QueryDelegationsRequest{Pagination: &query.PageRequest{Limit: 1}})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, rsp.Delegations, 1)
+	del := rsp.Delegations[0]
+	// double sha256 of the staking tx in reversed byte order
+	assert.Equal(t, "1614f4ce996a31a7fddae1f1ca3312a453b77bacb920fb34d0b940217e19c619", del.StakingTxHashHex)
+	assert.Equal(t, []string{"bb"}, del.FpBtcPkList)
+	assert.Equal(t, uint64(100), del.TotalSat)
+	assert.Equal(t, []byte(del.StakingTxHashHex), rsp.Pagination.NextKey)
+}
+
+func TestQueryActivatedHeightAndIndexedBlock(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			var q contract.BTCStakingQuery
+			require.NoError(t, json.Unmarshal(req, &q))
+			switch {
+			case q.ActivatedHeight != nil:
+				return json.Marshal(contract.ActivatedHeightResponse{Height: 1000})
+			case q.Block != nil:
+				return json.Marshal(contract.IndexedBlockResponse{Height: q.Block.Height, AppHash: []byte{0xa}, Finalized: true})
+			}
+			return nil, errors.New("unexpected query")
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	params := k.GetParams(ctx)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+	heightRsp, err := q.ActivatedHeight(ctx, &types.QueryActivatedHeightRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), heightRsp.Height)
+
+	blockRsp, err := q.IndexedBlock(ctx, &types.QueryIndexedBlockRequest{Height: 5})
+	require.NoError(t, err)
+	assert.Equal(t, types.IndexedBlock{Height: 5, AppHash: []byte{0xa}, Finalized: true}, blockRsp.Block)
+}
+
+func TestQueryBlock(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	indexed := map[uint64]contract.IndexedBlockResponse{
+		10: {Height: 10, AppHash: []byte{0x1}, Finalized: true},
+		11: {Height: 11, AppHash: []byte{0x2}},
+		13: {Height: 13, AppHash: []byte{0x3}},
+	}
+	mock := MockWasmKeeper{
+		QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			var q contract.BTCStakingQuery
+			require.NoError(t, json.Unmarshal(req, &q))
+			require.NotNil(t, q.Blocks)
+			require.NotNil(t, q.Blocks.StartAfter)
+			require.Nil(t, q.Blocks.Finalised)
+			// return the next indexed block above start_after
+			for h := *q.Blocks.StartAfter + 1; h <= 13; h++ {
+				if b, ok := indexed[h]; ok {
+					return json.Marshal(contract.BlocksResponse{Blocks: []contract.IndexedBlockResponse{b}})
+				}
+			}
+			return json.Marshal(contract.BlocksResponse{})
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	params := k.GetParams(ctx)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+	specs := map[string]struct {
+		height  uint64
+		exp     types.QueryBlockResponse
+		expCode codes.Code
+	}{
+		"finalized": {
+			height: 10,
+			exp:    types.QueryBlockResponse{Height: 10, Indexed: true, Finalized: true, AppHash: []byte{0x1}},
+		},
+		"indexed not finalized": {
+			height: 11,
+			exp:    types.QueryBlockResponse{Height: 11, Indexed: true, AppHash: []byte{0x2}},
+		},
+		"gap before next indexed block": {
+			height: 12,
+			exp:    types.QueryBlockResponse{Height: 12},
+		},
+		"beyond last indexed block": {
+			height: 20,
+			exp:    types.QueryBlockResponse{Height: 20},
+		},
+		"zero height": {
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rsp, gotErr := q.Block(ctx, &types.QueryBlockRequest{Height: spec.height})
+			if spec.expCode != codes.OK {
+				assert.Equal(t, spec.expCode, status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, *rsp)
+		})
+	}
+}
+
+func TestQueryFinalizedBlocks(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	var gotQueries []contract.BlocksQuery
+	mock := MockWasmKeeper{
+		QuerySmartFn: func(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+			var q contract.BTCStakingQuery
+			require.NoError(t, json.Unmarshal(req, &q))
+			require.NotNil(t, q.Blocks)
+			gotQueries = append(gotQueries, *q.Blocks)
+			if q.Blocks.Reverse != nil {
+				return json.Marshal(contract.BlocksResponse{Blocks: []contract.IndexedBlockResponse{{Height: 8, Finalized: true}}})
+			}
+			return json.Marshal(contract.BlocksResponse{Blocks: []contract.IndexedBlockResponse{
+				{Height: 6, Finalized: true},
+				{Height: 7, Finalized: true},
+			}})
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	params := k.GetParams(ctx)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+
+	// when
+	rsp, err := q.FinalizedBlocks(ctx, &types.QueryFinalizedBlocksRequest{
+		Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(5), Limit: 2},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []types.IndexedBlock{{Height: 6, Finalized: true}, {Height: 7, Finalized: true}}, rsp.Blocks)
+	assert.Equal(t, uint64(8), rsp.LatestFinalizedHeight)
+	assert.Equal(t, sdk.Uint64ToBigEndian(7), rsp.Pagination.NextKey)
+	require.Len(t, gotQueries, 2)
+	assert.Equal(t, uint64(5), *gotQueries[0].StartAfter)
+	assert.Equal(t, uint32(2), *gotQueries[0].Limit)
+	assert.True(t, *gotQueries[0].Finalised)
+	assert.Nil(t, gotQueries[0].Reverse)
+
+	// and invalid page key rejected
+	_, err = q.FinalizedBlocks(ctx, &types.QueryFinalizedBlocksRequest{Pagination: &query.PageRequest{Key: []byte{1}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
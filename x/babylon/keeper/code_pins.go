@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// SetCodePins stores the code checksums that the Babylon contracts are pinned to
+func (k Keeper) SetCodePins(ctx context.Context, pins types.CodePins) error {
+	if err := pins.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.CodePins.Set(ctx, pins); err != nil {
+		return err
+	}
+	k.invalidateHookTargets()
+	return nil
+}
+
+// GetCodePins returns the code checksums that the Babylon contracts are pinned to
+func (k Keeper) GetCodePins(ctx context.Context) types.CodePins {
+	pins, err := k.CodePins.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return pins
+}
+
+// contractPins returns the code pins of the contract. Only the Babylon and BTC staking contracts
+// configured in the params have pins.
+func contractPins(params types.Params, pins types.CodePins, contractAddr sdk.AccAddress) [][]byte {
+	var checksums [][]byte
+	addrStr := contractAddr.String()
+	if addrStr == params.BabylonContractAddress {
+		checksums = append(checksums, pins.BabylonContractChecksums...)
+	}
+	if addrStr == params.BtcStakingContractAddress {
+		checksums = append(checksums, pins.BtcStakingContractChecksums...)
+	}
+	return checksums
+}
+
+// isCodePinned returns true when the target contract is not pinned or runs one of the pinned checksums.
+// The code of the contract is looked up unless it was resolved with the target.
+// Otherwise the rejection is reported through logs and events.
+func (k Keeper) isCodePinned(ctx context.Context, target hookTarget, hook string) bool {
+	if len(target.pinnedChecksums) == 0 {
+		return true
+	}
+	contractAddr, checksum := target.contractAddr, target.checksum
+	if checksum == nil {
+		var err error
+		if checksum, err = k.GetContractChecksum(ctx, contractAddr); err != nil {
+			k.Logger(ctx).Error("failed to get contract code checksum", "hook", hook, "contract", contractAddr.String(), "error", err)
+			types.EmitCodeNotPinnedEvent(ctx, contractAddr, hook, nil)
+			return false
+		}
+	}
+	if !types.IsPinned(target.pinnedChecksums, checksum) {
+		k.Logger(ctx).Error("refusing to call unpinned contract code", "hook", hook, "contract", contractAddr.String(), "checksum", checksum)
+		types.EmitCodeNotPinnedEvent(ctx, contractAddr, hook, checksum)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestBlockHooksRespectCodePins(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myChecksum := bytes.Repeat([]byte{1}, types.ChecksumLength)
+	otherChecksum := bytes.Repeat([]byte{2}, types.ChecksumLength)
+
+	specs := map[string]struct {
+		pins        types.CodePins
+		contractRun []byte
+		expCalled   bool
+	}{
+		"no pins": {
+			contractRun: myChecksum,
+			expCalled:   true,
+		},
+		"pinned code": {
+			pins:        types.CodePins{BtcStakingContractChecksums: [][]byte{otherChecksum, myChecksum}},
+			contractRun: myChecksum,
+			expCalled:   true,
+		},
+		"unpinned code": {
+			pins:        types.CodePins{BtcStakingContractChecksums: [][]byte{otherChecksum}},
+			contractRun: myChecksum,
+		},
+		"pins of other contract": {
+			pins:        types.CodePins{BabylonContractChecksums: [][]byte{otherChecksum}},
+			contractRun: myChecksum,
+			expCalled:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var sudoCalls int
+			mock := MockWasmKeeper{
+				HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+					return true
+				},
+				GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					return &wasmtypes.ContractInfo{CodeID: 1}
+				},
+				GetCodeInfoFn: func(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo {
+					return &wasmtypes.CodeInfo{CodeHash: spec.contractRun}
+				},
+				SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					sudoCalls++
+					return nil, nil
+				},
+			}
+			keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx.WithEventManager(sdk.NewEventManager())
+			params := k.GetParams(ctx)
+			params.BtcStakingContractAddress = myContractAddr.String()
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetCodePins(ctx, spec.pins))
+
+			// when
+			require.NoError(t, k.BeginBlocker(ctx))
+
+			// then
+			var rejected bool
+			for _, e := range ctx.EventManager().Events() {
+				rejected = rejected || e.Type == types.EventTypeCodeNotPinned
+			}
+			if spec.expCalled {
+				assert.Equal(t, 1, sudoCalls)
+				assert.False(t, rejected)
+				return
+			}
+			assert.Equal(t, 0, sudoCalls)
+			assert.True(t, rejected)
+			// a rejection is not a hook failure
+			assert.Equal(t, types.HookStatus{}, k.GetHookStatus(ctx, myContractAddr))
+		})
+	}
+}
+
+func TestMsgUpdateCodePins(t *testing.T) {
+	myAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	myChecksum := bytes.Repeat([]byte{1}, types.ChecksumLength)
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ms := keeper.NewMsgServer(k)
+
+	specs := map[string]struct {
+		src    types.MsgUpdateCodePins
+		expErr error
+	}{
+		"valid": {
+			src: types.MsgUpdateCodePins{Authority: myAuthority, Pins: types.CodePins{BabylonContractChecksums: [][]byte{myChecksum}}},
+		},
+		"empty pins": {
+			src: types.MsgUpdateCodePins{Authority: myAuthority},
+		},
+		"invalid authority": {
+			src:    types.MsgUpdateCodePins{Authority: sdk.AccAddress(rand.Bytes(32)).String()},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"invalid checksum": {
+			src:    types.MsgUpdateCodePins{Authority: myAuthority, Pins: types.CodePins{BtcStakingContractChecksums: [][]byte{{1}}}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"duplicate checksum": {
+			src:    types.MsgUpdateCodePins{Authority: myAuthority, Pins: types.CodePins{BtcStakingContractChecksums: [][]byte{myChecksum, myChecksum}}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := keepers.Ctx.CacheContext()
+			_, gotErr := ms.UpdateCodePins(ctx, &spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.src.Pins, k.GetCodePins(ctx))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// BabylonContractLabel is the label of the Babylon contract instantiated by the module
+const BabylonContractLabel = "babylon"
+
+// InstantiateBabylonContracts instantiates the Babylon contract under the module account. The Babylon contract
+// instantiates the BTC staking contract from the given code ID and init message. Both addresses are stored in
+// the params.
+func (k Keeper) InstantiateBabylonContracts(
+	ctx context.Context,
+	babylonCodeID, btcStakingCodeID uint64,
+	admin sdk.AccAddress,
+	babylonInitMsg, btcStakingInitMsg []byte,
+) (babylonAddr, btcStakingAddr sdk.AccAddress, err error) {
+	if k.contractOps == nil {
+		return nil, nil, types.ErrUnsupported.Wrap("no contract ops keeper set")
+	}
+	// the code is checked against the same pins as the configured contracts before anything is instantiated
+	pins := k.GetCodePins(ctx)
+	if err := k.validateCodePinned(ctx, "babylon", babylonCodeID, pins.BabylonContractChecksums); err != nil {
+		return nil, nil, err
+	}
+	if err := k.validateCodePinned(ctx, "btc staking", btcStakingCodeID, pins.BtcStakingContractChecksums); err != nil {
+		return nil, nil, err
+	}
+	initMsg, err := buildBabylonInitMsg(babylonInitMsg, btcStakingCodeID, btcStakingInitMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+	creator := authtypes.NewModuleAddress(types.ModuleName)
+	babylonAddr, _, err = k.contractOps.Instantiate(sdk.UnwrapSDKContext(ctx), babylonCodeID, creator, admin, initMsg, BabylonContractLabel, nil)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "instantiate babylon contract")
+	}
+
+	req, err := json.Marshal(contract.BabylonQuery{Config: &struct{}{}})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "marshal config query")
+	}
+	res, err := k.wasm.QuerySmart(ctx, babylonAddr, req)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "query babylon contract config")
+	}
+	var cfg contract.BabylonConfigResponse
+	if err := json.Unmarshal(res, &cfg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "babylon contract config")
+	}
+	btcStakingAddr, err = sdk.AccAddressFromBech32(cfg.BTCStaking)
+	if err != nil {
+		return nil, nil, types.ErrInvalid.Wrapf("btc staking contract address %q: %s", cfg.BTCStaking, err)
+	}
+
+	params := k.GetParams(ctx)
+	params.BabylonContractAddress = babylonAddr.String()
+	params.BtcStakingContractAddress = btcStakingAddr.String()
+	if err := k.ValidateContracts(ctx, params); err != nil {
+		return nil, nil, err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, nil, err
+	}
+	types.EmitBabylonContractsInstantiatedEvent(ctx, babylonAddr, btcStakingAddr)
+	return babylonAddr, btcStakingAddr, nil
+}
+
+// buildBabylonInitMsg sets the BTC staking contract code ID and init message in the Babylon contract init message
+func buildBabylonInitMsg(babylonInitMsg []byte, btcStakingCodeID uint64, btcStakingInitMsg []byte) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(babylonInitMsg) != 0 {
+		if err := json.Unmarshal(babylonInitMsg, &fields); err != nil {
+			return nil, types.ErrInvalid.Wrapf("babylon init msg: %s", err)
+		}
+	}
+	codeID, err := json.Marshal(btcStakingCodeID)
+	if err != nil {
+		return nil, err
+	}
+	fields["btc_staking_code_id"] = codeID
+	delete(fields, "btc_staking_msg")
+	if len(btcStakingInitMsg) != 0 {
+		// the contract expects the init message as base64 encoded binary
+		bz, err := json.Marshal(btcStakingInitMsg)
+		if err != nil {
+			return nil, err
+		}
+		fields["btc_staking_msg"] = bz
+	}
+	return json.Marshal(fields)
+}
+
+// ValidateContracts ensures that the contracts configured in the params exist and run code within their pins.
+// The pins are the same that the block hooks enforce, see isCodePinned.
+func (k Keeper) ValidateContracts(ctx context.Context, params types.Params) error {
+	pins := k.GetCodePins(ctx)
+	for _, c := range []struct{ name, addr string }{
+		{name: "babylon", addr: params.BabylonContractAddress},
+		{name: "btc staking", addr: params.BtcStakingContractAddress},
+	} {
+		name, addrStr := c.name, c.addr
+		if len(addrStr) == 0 {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return types.ErrInvalid.Wrapf("%s contract address: %s", name, err)
+		}
+		checksum, err := k.GetContractChecksum(ctx, addr)
+		if err != nil {
+			return errorsmod.Wrapf(err, "%s contract", name)
+		}
+		if !types.IsPinned(contractPins(params, pins, addr), checksum) {
+			return types.ErrInvalid.Wrapf("%s contract code checksum %X not pinned", name, checksum)
+		}
+	}
+	return nil
+}
+
+// validateCodePinned ensures that the code is one of the pinned checksums, if any
+func (k Keeper) validateCodePinned(ctx context.Context, name string, codeID uint64, checksums [][]byte) error {
+	codeInfo := k.wasm.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return types.ErrNotFound.Wrapf("%s contract code %d", name, codeID)
+	}
+	if !types.IsPinned(checksums, codeInfo.CodeHash) {
+		return types.ErrInvalid.Wrapf("%s contract code checksum %X not pinned", name, codeInfo.CodeHash)
+	}
+	return nil
+}
+
+// GetContractChecksum returns the checksum of the code that the contract currently runs
+func (k Keeper) GetContractChecksum(ctx context.Context, addr sdk.AccAddress) ([]byte, error) {
+	contractInfo := k.wasm.GetContractInfo(ctx, addr)
+	if contractInfo == nil {
+		return nil, types.ErrNotFound.Wrapf("contract %s", addr)
+	}
+	codeInfo := k.wasm.GetCodeInfo(ctx, contractInfo.CodeID)
+	if codeInfo == nil {
+		return nil, types.ErrNotFound.Wrapf("code %d", contractInfo.CodeID)
+	}
+	return codeInfo.CodeHash, nil
+}
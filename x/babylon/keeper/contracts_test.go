@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+const (
+	babylonContractFile    = "../../../tests/testdata/babylon_contract.wasm"
+	btcStakingContractFile = "../../../tests/testdata/btc_staking.wasm"
+)
+
+func TestMsgInstantiateBabylonContracts(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(keepers.WasmKeeper)
+	creator := sdk.AccAddress(rand.Bytes(32))
+	babylonCodeID, _, err := contractKeeper.Create(ctx, creator, readTestContract(t, babylonContractFile), nil)
+	require.NoError(t, err)
+	btcStakingCodeID, _, err := contractKeeper.Create(ctx, creator, readTestContract(t, btcStakingContractFile), nil)
+	require.NoError(t, err)
+
+	msg := types.MsgInstantiateBabylonContracts{
+		Authority:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		BabylonContractCodeId:    babylonCodeID,
+		BtcStakingContractCodeId: btcStakingCodeID,
+		BabylonInitMsg:           testBabylonInitMsg(t),
+		BtcStakingInitMsg:        []byte(`{"admin":"` + creator.String() + `"}`),
+		Admin:                    creator.String(),
+	}
+	msgServer := keeper.NewMsgServer(k)
+
+	// invalid authority rejected
+	invalidMsg := msg
+	invalidMsg.Authority = creator.String()
+	_, err = msgServer.InstantiateBabylonContracts(ctx, &invalidMsg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// missing code id rejected
+	invalidMsg = msg
+	invalidMsg.BtcStakingContractCodeId = 0
+	_, err = msgServer.InstantiateBabylonContracts(ctx, &invalidMsg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+	// invalid init msg rejected
+	invalidMsg = msg
+	invalidMsg.BtcStakingInitMsg = []byte(`{"admin":`)
+	_, err = msgServer.InstantiateBabylonContracts(ctx, &invalidMsg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+	// unpinned code rejected
+	btcStakingCodeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, btcStakingCodeID)
+	require.NoError(t, k.SetCodePins(ctx, types.CodePins{BtcStakingContractChecksums: [][]byte{bytes.Repeat([]byte{1}, types.ChecksumLength)}}))
+	_, err = msgServer.InstantiateBabylonContracts(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+	assert.Empty(t, k.GetParams(ctx).BabylonContractAddress)
+	require.NoError(t, k.SetCodePins(ctx, types.CodePins{BtcStakingContractChecksums: [][]byte{btcStakingCodeInfo.CodeHash}}))
+
+	// when
+	rsp, err := msgServer.InstantiateBabylonContracts(ctx, &msg)
+
+	// then
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	assert.Equal(t, rsp.BabylonContractAddress, params.BabylonContractAddress)
+	assert.Equal(t, rsp.BtcStakingContractAddress, params.BtcStakingContractAddress)
+	btcStakingAddr := sdk.MustAccAddressFromBech32(rsp.BtcStakingContractAddress)
+	require.True(t, keepers.WasmKeeper.HasContractInfo(ctx, btcStakingAddr))
+	assert.Equal(t, btcStakingCodeID, keepers.WasmKeeper.GetContractInfo(ctx, btcStakingAddr).CodeID)
+	babylonInfo := keepers.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(rsp.BabylonContractAddress))
+	assert.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), babylonInfo.Creator)
+	assert.Equal(t, creator.String(), babylonInfo.Admin)
+}
+
+func TestInitGenesisContractsBootstrap(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	admin := sdk.AccAddress(rand.Bytes(32))
+	// the btc staking contract code is stored by the wasm genesis
+	btcStakingCodeID, _, err := wasmkeeper.NewGovPermissionKeeper(keepers.WasmKeeper).
+		Create(ctx, admin, readTestContract(t, btcStakingContractFile), nil)
+	require.NoError(t, err)
+	genState := types.DefaultGenesisState(sdk.DefaultBondDenom)
+	genState.ContractsBootstrap = &types.ContractsBootstrap{
+		BabylonContractCode:      readTestContract(t, babylonContractFile),
+		BtcStakingContractCodeId: btcStakingCodeID,
+		BabylonInitMsg:           testBabylonInitMsg(t),
+		BtcStakingInitMsg:        []byte(`{"admin":"` + admin.String() + `"}`),
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	// when
+	k.InitGenesis(ctx, *genState)
+
+	// then
+	params := k.GetParams(ctx)
+	require.NotEmpty(t, params.BabylonContractAddress)
+	require.NotEmpty(t, params.BtcStakingContractAddress)
+	babylonInfo := keepers.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(params.BabylonContractAddress))
+	require.NotNil(t, babylonInfo)
+	assert.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), babylonInfo.Creator)
+	btcStakingInfo := keepers.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(params.BtcStakingContractAddress))
+	require.NotNil(t, btcStakingInfo)
+	assert.Equal(t, btcStakingCodeID, btcStakingInfo.CodeID)
+	// and the bootstrap is not exported
+	assert.Nil(t, k.ExportGenesis(ctx).ContractsBootstrap)
+}
+
+func readTestContract(t *testing.T, file string) []byte {
+	t.Helper()
+	code, err := os.ReadFile(file)
+	require.NoError(t, err)
+	return code
+}
+
+func testBabylonInitMsg(t *testing.T) []byte {
+	t.Helper()
+	bz, err := json.Marshal(map[string]any{
+		"network":                         "regtest",
+		"babylon_tag":                     "01020304",
+		"btc_confirmation_depth":          1,
+		"checkpoint_finalization_timeout": 2,
+		"notify_cosmos_zone":              false,
+	})
+	require.NoError(t, err)
+	return bz
+}
@@ -1,18 +1,131 @@
 package keeper
 
 import (
+	"context"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
 
-func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+	for _, s := range data.HookStatuses {
+		k.setHookStatus(ctx, sdk.MustAccAddressFromBech32(s.ContractAddress), s.Status)
+	}
+	for _, a := range data.ContractAuthorizations {
+		if err := k.SetContractAuthorization(ctx, a); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetStakingMsgPolicy(ctx, data.StakingMsgPolicy); err != nil {
+		panic(err)
+	}
+	for _, t := range data.ScheduledTasks {
+		phase, err := types.SchedulerPhaseFromHook(t.Phase)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.setScheduledTask(ctx, sdk.MustAccAddressFromBech32(t.ContractAddress), phase, t.Height, t.Work); err != nil {
+			panic(err)
+		}
+	}
+	for _, h := range data.IndexedHeaders {
+		if err := k.setIndexedHeader(ctx, h); err != nil {
+			panic(err)
+		}
+	}
+	for _, v := range data.ValidatorSet {
+		if err := k.setConsumerValidator(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetCodePins(ctx, data.CodePins); err != nil {
+		panic(err)
+	}
+	for _, r := range data.HookRegistrations {
+		if err := k.SetHookRegistration(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+	if data.ContractsBootstrap != nil {
+		if err := k.bootstrapContracts(ctx, *data.ContractsBootstrap); err != nil {
+			panic(err)
+		}
+	}
 }
 
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+// bootstrapContracts stores the wasm code of the Babylon contracts, if given, and instantiates them
+func (k Keeper) bootstrapContracts(ctx context.Context, b types.ContractsBootstrap) error {
+	if k.contractOps == nil {
+		return types.ErrUnsupported.Wrap("no contract ops keeper set")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := authtypes.NewModuleAddress(types.ModuleName)
+	babylonCodeID, btcStakingCodeID := b.BabylonContractCodeId, b.BtcStakingContractCodeId
+	if len(b.BabylonContractCode) != 0 {
+		var err error
+		if babylonCodeID, _, err = k.contractOps.Create(sdkCtx, creator, b.BabylonContractCode, nil); err != nil {
+			return errorsmod.Wrap(err, "store babylon contract code")
+		}
+	}
+	if len(b.BtcStakingContractCode) != 0 {
+		var err error
+		if btcStakingCodeID, _, err = k.contractOps.Create(sdkCtx, creator, b.BtcStakingContractCode, nil); err != nil {
+			return errorsmod.Wrap(err, "store btc staking contract code")
+		}
+	}
+	var admin sdk.AccAddress
+	if len(b.Admin) != 0 {
+		admin = sdk.MustAccAddressFromBech32(b.Admin)
+	}
+	_, _, err := k.InstantiateBabylonContracts(ctx, babylonCodeID, btcStakingCodeID, admin, b.BabylonInitMsg, b.BtcStakingInitMsg)
+	return err
+}
+
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	genState := types.NewGenesisState(k.GetParams(ctx))
+	k.IterateHookStatuses(ctx, func(contractAddr sdk.AccAddress, status types.HookStatus) bool {
+		genState.HookStatuses = append(genState.HookStatuses, types.GenesisHookStatus{
+			ContractAddress: contractAddr.String(),
+			Status:          status,
+		})
+		return false
+	})
+	k.IterateContractAuthorizations(ctx, func(auth types.ContractAuthorization) bool {
+		genState.ContractAuthorizations = append(genState.ContractAuthorizations, auth)
+		return false
+	})
+	genState.StakingMsgPolicy = k.GetStakingMsgPolicy(ctx)
+	for _, phase := range []types.SchedulerPhase{types.SchedulerPhaseBeginBlock, types.SchedulerPhaseEndBlock} {
+		err := k.IterateScheduledTasks(ctx, phase, ^uint64(0), func(contractAddr sdk.AccAddress, height uint64, work types.ScheduledWork) bool {
+			genState.ScheduledTasks = append(genState.ScheduledTasks, types.GenesisScheduledTask{
+				ContractAddress: contractAddr.String(),
+				Phase:           phase.Hook(),
+				Height:          height,
+				Work:            work,
+			})
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.IterateIndexedHeaders(ctx, func(header types.IndexedHeader) bool {
+		genState.IndexedHeaders = append(genState.IndexedHeaders, header)
+		return false
+	})
+	k.IterateConsumerValidators(ctx, func(v types.ConsumerValidator) bool {
+		genState.ValidatorSet = append(genState.ValidatorSet, v)
+		return false
+	})
+	genState.CodePins = k.GetCodePins(ctx)
+	k.IterateHookRegistrations(ctx, func(reg types.HookRegistration) bool {
+		genState.HookRegistrations = append(genState.HookRegistrations, reg)
+		return false
+	})
+	return genState
 }
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
+	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +23,7 @@ func TestInitGenesis(t *testing.T) {
 			state: types.GenesisState{
 				Params: types.Params{
 					MaxGasBeginBlocker: 600_000,
+					MaxGasEndBlocker:   700_000,
 				},
 			},
 			expErr: false,
@@ -28,6 +32,7 @@ func TestInitGenesis(t *testing.T) {
 			state: types.GenesisState{
 				Params: types.Params{
 					MaxGasBeginBlocker: 10_000,
+					MaxGasEndBlocker:   20_000,
 				},
 			},
 			expErr: false,
@@ -43,6 +48,7 @@ func TestInitGenesis(t *testing.T) {
 
 			p := k.GetParams(keepers.Ctx)
 			assert.Equal(t, spec.state.Params.MaxGasBeginBlocker, p.MaxGasBeginBlocker)
+			assert.Equal(t, spec.state.Params.MaxGasEndBlocker, p.MaxGasEndBlocker)
 		})
 	}
 }
@@ -57,4 +63,43 @@ func TestExportGenesis(t *testing.T) {
 
 	exported := k.ExportGenesis(keepers.Ctx)
 	assert.Equal(t, params.MaxGasBeginBlocker, exported.Params.MaxGasBeginBlocker)
+	assert.Equal(t, params.MaxGasEndBlocker, exported.Params.MaxGasEndBlocker)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32)).String()
+	myOtherContractAddr := sdk.AccAddress(rand.Bytes(32)).String()
+	myTime := time.Unix(1_700_000_000, 0).UTC()
+	myState := types.GenesisState{
+		Params: types.DefaultParams(sdk.DefaultBondDenom),
+		HookStatuses: []types.GenesisHookStatus{
+			{ContractAddress: myContractAddr, Status: types.HookStatus{ConsecutiveFailures: 2}},
+		},
+		ContractAuthorizations: []types.ContractAuthorization{
+			{ContractAddress: myOtherContractAddr, MsgTypes: []string{types.CustomMsgTypeBurnSlashed}},
+		},
+		StakingMsgPolicy: types.StakingMsgPolicy{DefaultAllow: true, DeniedContracts: []string{myOtherContractAddr}},
+		ScheduledTasks: []types.GenesisScheduledTask{
+			{ContractAddress: myContractAddr, Phase: types.SudoHookBeginBlock, Height: 10, Work: types.ScheduledWork{Repeat: true, Interval: 5}},
+			{ContractAddress: myContractAddr, Phase: types.SudoHookBeginBlock, Height: 20, Work: types.ScheduledWork{Payload: []byte("my-payload")}},
+			{ContractAddress: myContractAddr, Phase: types.SudoHookEndBlock, Height: 10, Work: types.ScheduledWork{}},
+		},
+		IndexedHeaders: []types.IndexedHeader{
+			{Height: 1, Hash: []byte{0x1}, AppHash: []byte{0x2}, Time: myTime},
+			{Height: 2, Hash: []byte{0x3}, AppHash: []byte{0x4}, Time: myTime.Add(time.Second)},
+		},
+		CodePins: types.CodePins{BtcStakingContractChecksums: [][]byte{bytes.Repeat([]byte{1}, types.ChecksumLength)}},
+		HookRegistrations: []types.HookRegistration{
+			{ContractAddress: myContractAddr, Hook: types.SudoHookBeginBlock, GasLimit: 100_000, Priority: 1},
+			{ContractAddress: myOtherContractAddr, Hook: types.SudoHookEndBlock},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(&myState))
+
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	k.InitGenesis(keepers.Ctx, myState)
+
+	exported := k.ExportGenesis(keepers.Ctx)
+	assert.Equal(t, myState, *exported)
 }
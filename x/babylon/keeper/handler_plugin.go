@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
+	"strings"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -9,6 +11,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
@@ -17,21 +20,27 @@ import (
 // AuthSource abstract type that provides contract authorization.
 // This is an extension point for custom implementations.
 type AuthSource interface {
-	// IsAuthorized returns if the contract authorized to execute a virtual stake message
-	IsAuthorized(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	// IsAuthorized returns if the contract authorized to execute the given type of Babylon custom message
+	IsAuthorized(ctx context.Context, contractAddr sdk.AccAddress, msgType string) bool
 }
 
 // abstract keeper
-type msKeeper interface{}
+type msKeeper interface {
+	MintBlockRewards(ctx context.Context, actor, recipient sdk.AccAddress, amt sdk.Coin) error
+	BurnSlashed(ctx context.Context, actor sdk.AccAddress, amt sdk.Coin) error
+	ScheduleTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64, work types.ScheduledWork) error
+	UnscheduleTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64) error
+}
 
 type CustomMsgHandler struct {
 	k    msKeeper
 	auth AuthSource
 }
 
-// NewDefaultCustomMsgHandler constructor to set up the CustomMsgHandler with default max cap authorization
+// NewDefaultCustomMsgHandler constructor to set up the CustomMsgHandler with the default authorization
+// registry of the module
 func NewDefaultCustomMsgHandler(k *Keeper) *CustomMsgHandler {
-	return &CustomMsgHandler{k: k, auth: defaultMaxCapAuthorizator(k)}
+	return &CustomMsgHandler{k: k, auth: defaultAuthorizator(k)}
 }
 
 // NewCustomMsgHandler constructor to set up CustomMsgHandler with an individual auth source.
@@ -40,10 +49,10 @@ func NewCustomMsgHandler(k msKeeper, auth AuthSource) *CustomMsgHandler {
 	return &CustomMsgHandler{k: k, auth: auth}
 }
 
-func defaultMaxCapAuthorizator(k *Keeper) AuthSourceFn {
-	return func(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
-		return true
-	}
+// defaultAuthorizator authorizes the contracts configured in the params and those
+// registered in the module's authorization registry
+func defaultAuthorizator(k *Keeper) AuthSourceFn {
+	return k.IsContractAuthorized
 }
 
 // DispatchMsg handle contract message of type Custom in the babylon namespace
@@ -55,52 +64,155 @@ func (h CustomMsgHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
 		return nil, nil, nil, sdkerrors.ErrJSONUnmarshal.Wrap("custom message")
 	}
-	if customMsg.Test == nil {
+	var msgType string
+	switch {
+	case customMsg.MintRewards != nil:
+		msgType = types.CustomMsgTypeMintRewards
+	case customMsg.BurnSlashed != nil:
+		msgType = types.CustomMsgTypeBurnSlashed
+	case customMsg.ScheduleTask != nil, customMsg.UnscheduleTask != nil:
+		msgType = types.CustomMsgTypeScheduleTask
+	default:
 		// not our message type
 		return nil, nil, nil, wasmtypes.ErrUnknownMsg
 	}
 
-	if !h.auth.IsAuthorized(ctx, contractAddr) {
-		return nil, nil, nil, sdkerrors.ErrUnauthorized.Wrapf("contract has no permission for Babylon operations")
+	if !h.auth.IsAuthorized(ctx, contractAddr, msgType) {
+		return nil, nil, nil, sdkerrors.ErrUnauthorized.Wrapf("contract has no permission for Babylon %s operations", msgType)
 	}
 
-	return h.handleTestMsg(ctx, contractAddr, customMsg.Test)
+	switch {
+	case customMsg.MintRewards != nil:
+		return h.handleMintRewardsMsg(ctx, contractAddr, customMsg.MintRewards)
+	case customMsg.BurnSlashed != nil:
+		return h.handleBurnSlashedMsg(ctx, contractAddr, customMsg.BurnSlashed)
+	case customMsg.ScheduleTask != nil:
+		return h.handleScheduleTaskMsg(ctx, contractAddr, customMsg.ScheduleTask)
+	default:
+		return h.handleUnscheduleTaskMsg(ctx, contractAddr, customMsg.UnscheduleTask)
+	}
+}
+
+func (h CustomMsgHandler) handleMintRewardsMsg(ctx sdk.Context, actor sdk.AccAddress, mintMsg *contract.MintRewardsMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	recipient, err := sdk.AccAddressFromBech32(mintMsg.Recipient)
+	if err != nil {
+		return nil, nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(mintMsg.Amount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := h.k.MintBlockRewards(ctx, actor, recipient, coin); err != nil {
+		return nil, nil, nil, err
+	}
+	return []sdk.Event{}, nil, nil, nil
 }
 
-func (h CustomMsgHandler) handleTestMsg(ctx sdk.Context, actor sdk.AccAddress, testMsg *contract.TestMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+func (h CustomMsgHandler) handleBurnSlashedMsg(ctx sdk.Context, actor sdk.AccAddress, burnMsg *contract.BurnSlashedMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(burnMsg.Amount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := h.k.BurnSlashed(ctx, actor, coin); err != nil {
+		return nil, nil, nil, err
+	}
+	return []sdk.Event{}, nil, nil, nil
+}
+
+func (h CustomMsgHandler) handleScheduleTaskMsg(ctx sdk.Context, actor sdk.AccAddress, scheduleMsg *contract.ScheduleTaskMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	phase, err := types.SchedulerPhaseFromHook(scheduleMsg.Phase)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	work := types.ScheduledWork{
+		Repeat:   scheduleMsg.Interval != 0,
+		Interval: scheduleMsg.Interval,
+		Payload:  scheduleMsg.Payload,
+	}
+	if err := h.k.ScheduleTask(ctx, actor, phase, scheduleMsg.Height, work); err != nil {
+		return nil, nil, nil, err
+	}
+	return []sdk.Event{}, nil, nil, nil
+}
+
+func (h CustomMsgHandler) handleUnscheduleTaskMsg(ctx sdk.Context, actor sdk.AccAddress, unscheduleMsg *contract.UnscheduleTaskMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	phase, err := types.SchedulerPhaseFromHook(unscheduleMsg.Phase)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := h.k.UnscheduleTask(ctx, actor, phase, unscheduleMsg.Height); err != nil {
+		return nil, nil, nil, err
+	}
 	return []sdk.Event{}, nil, nil, nil
 }
 
 // AuthSourceFn is helper for simple AuthSource types
-type AuthSourceFn func(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+type AuthSourceFn func(ctx context.Context, contractAddr sdk.AccAddress, msgType string) bool
 
-// IsAuthorized returns if the contract authorized to execute a virtual stake message
-func (a AuthSourceFn) IsAuthorized(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
-	return a(ctx, contractAddr)
+// IsAuthorized returns if the contract authorized to execute the given type of Babylon custom message
+func (a AuthSourceFn) IsAuthorized(ctx context.Context, contractAddr sdk.AccAddress, msgType string) bool {
+	return a(ctx, contractAddr, msgType)
 }
 
 // abstract keeper
 type integrityHandlerSource interface {
-	CanInvokeStakingMsg(ctx sdk.Context, actor sdk.AccAddress) bool
+	CanInvokeStakingMsg(ctx context.Context, actor sdk.AccAddress) bool
 }
 
-// NewIntegrityHandler prevents any contract with max cap set to use staking
-// or stargate messages. This ensures that staked "virtual" tokens are not bypassing
-// the instant undelegate and burn mechanism provided by babylon.
+// NewIntegrityHandler prevents any contract that is not permitted by the staking message policy
+// to use staking messages or stargate/any messages wrapping them. This ensures that staked "virtual"
+// tokens are not bypassing the instant undelegate and burn mechanism provided by babylon.
+//
+// Rejected messages fail with a types.StakingMsgRejectedError. The events of a failed message are discarded,
+// so the rejection event is emitted by the module when a block hook or scheduled task of the contract fails
+// with this error. Transactions fail with the error that names the contract and the staking message type.
 //
 // This handler should be chained before any other.
-// TODO: access control for msg call from contracts
 func NewIntegrityHandler(k integrityHandlerSource) wasmkeeper.MessageHandlerFunc {
 	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
-		if msg.Staking == nil || !k.CanInvokeStakingMsg(ctx, contractAddr) {
+		msgType, isStaking := stakingMsgType(msg)
+		if !isStaking || k.CanInvokeStakingMsg(ctx, contractAddr) {
 			return nil, nil, nil, wasmtypes.ErrUnknownMsg // pass down the chain
 		}
 		// reject
-		return nil, nil, nil, types.ErrUnsupported
+		return nil, nil, nil, &types.StakingMsgRejectedError{ContractAddress: contractAddr.String(), MsgType: msgType}
+	}
+}
+
+// stakingMsgType returns the type of the staking message when the given message is a staking message or
+// an any/stargate message that wraps one, including staking messages executed via authz.
+func stakingMsgType(msg wasmvmtypes.CosmosMsg) (string, bool) {
+	switch {
+	case msg.Staking != nil:
+		return "staking", true
+	case msg.Any != nil:
+		return anyStakingMsgType(msg.Any.TypeURL, msg.Any.Value)
+	default:
+		return "", false
 	}
 }
 
-func (k Keeper) CanInvokeStakingMsg(ctx sdk.Context, actor sdk.AccAddress) bool {
-	// TODO: implement access control
-	return true
+func anyStakingMsgType(typeURL string, value []byte) (string, bool) {
+	if strings.HasPrefix(typeURL, stakingTypeURLPrefix) {
+		return typeURL, true
+	}
+	if typeURL != authzExecTypeURL {
+		return "", false
+	}
+	var execMsg authz.MsgExec
+	if err := execMsg.Unmarshal(value); err != nil {
+		// let the message router reject the malformed message
+		return "", false
+	}
+	for _, m := range execMsg.Msgs {
+		if msgType, ok := anyStakingMsgType(m.TypeUrl, m.Value); ok {
+			return msgType, true
+		}
+	}
+	return "", false
 }
+
+// stakingTypeURLPrefix matches the type urls of all messages of the staking module
+const stakingTypeURLPrefix = "/cosmos.staking."
+
+var authzExecTypeURL = sdk.MsgTypeURL(&authz.MsgExec{})
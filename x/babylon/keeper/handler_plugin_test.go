@@ -0,0 +1,342 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestCustomMsgHandlerDispatchMsg(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myRecipient := sdk.AccAddress(rand.Bytes(32))
+	denom := sdk.DefaultBondDenom
+
+	specs := map[string]struct {
+		src          contract.CustomMsg
+		auth         keeper.AuthSource
+		expErr       error
+		expEvent     string
+		expRecipient sdkmath.Int
+		expContract  sdkmath.Int
+	}{
+		"mint rewards": {
+			src: contract.CustomMsg{
+				MintRewards: &contract.MintRewardsMsg{
+					Recipient: myRecipient.String(),
+					Amount:    wasmvmtypes.NewCoin(100, denom),
+				},
+			},
+			expEvent:     types.EventTypeMintRewards,
+			expRecipient: sdkmath.NewInt(100),
+			expContract:  sdkmath.NewInt(1_000),
+		},
+		"mint rewards - invalid recipient": {
+			src: contract.CustomMsg{
+				MintRewards: &contract.MintRewardsMsg{
+					Recipient: "invalid",
+					Amount:    wasmvmtypes.NewCoin(100, denom),
+				},
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"mint rewards - foreign denom": {
+			src: contract.CustomMsg{
+				MintRewards: &contract.MintRewardsMsg{
+					Recipient: myRecipient.String(),
+					Amount:    wasmvmtypes.NewCoin(100, "ibc/ABCD"),
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
+		"mint rewards - over the cap": {
+			src: contract.CustomMsg{
+				MintRewards: &contract.MintRewardsMsg{
+					Recipient: myRecipient.String(),
+					Amount:    wasmvmtypes.NewCoin(151, denom),
+				},
+			},
+			expErr: types.ErrMaxCapExceeded,
+		},
+		"mint rewards - zero amount": {
+			src: contract.CustomMsg{
+				MintRewards: &contract.MintRewardsMsg{
+					Recipient: myRecipient.String(),
+					Amount:    wasmvmtypes.NewCoin(0, denom),
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
+		"burn slashed": {
+			src: contract.CustomMsg{
+				BurnSlashed: &contract.BurnSlashedMsg{
+					Amount: wasmvmtypes.NewCoin(400, denom),
+				},
+			},
+			expEvent:     types.EventTypeBurnSlashed,
+			expRecipient: sdkmath.ZeroInt(),
+			expContract:  sdkmath.NewInt(600),
+		},
+		"burn slashed - insufficient funds": {
+			src: contract.CustomMsg{
+				BurnSlashed: &contract.BurnSlashedMsg{
+					Amount: wasmvmtypes.NewCoin(1_001, denom),
+				},
+			},
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		"schedule task": {
+			src: contract.CustomMsg{
+				ScheduleTask: &contract.ScheduleTaskMsg{
+					Phase:    types.SudoHookEndBlock,
+					Height:   2_000_000,
+					Interval: 10,
+				},
+			},
+			expEvent:     types.EventTypeSchedulerRegistered,
+			expRecipient: sdkmath.ZeroInt(),
+			expContract:  sdkmath.NewInt(1_000),
+		},
+		"schedule task - invalid phase": {
+			src: contract.CustomMsg{
+				ScheduleTask: &contract.ScheduleTaskMsg{
+					Phase:  "unknown",
+					Height: 2_000_000,
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
+		"unschedule task - not found": {
+			src: contract.CustomMsg{
+				UnscheduleTask: &contract.UnscheduleTaskMsg{
+					Phase:  types.SudoHookBeginBlock,
+					Height: 2_000_000,
+				},
+			},
+			expErr: types.ErrNotFound,
+		},
+		"unauthorized": {
+			src: contract.CustomMsg{
+				BurnSlashed: &contract.BurnSlashedMsg{
+					Amount: wasmvmtypes.NewCoin(400, denom),
+				},
+			},
+			auth: keeper.AuthSourceFn(func(ctx context.Context, contractAddr sdk.AccAddress, msgType string) bool {
+				return false
+			}),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown message": {
+			src:    contract.CustomMsg{},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keepers := NewTestKeepers(t)
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx.WithEventManager(sdk.NewEventManager())
+			keepers.Faucet.Fund(ctx, myContractAddr, sdk.NewInt64Coin(denom, 1_000))
+			params := k.GetParams(ctx)
+			params.BtcStakingContractAddress = myContractAddr.String()
+			params.MaxBlockRewards = 150
+			require.NoError(t, k.SetParams(ctx, params))
+
+			h := keeper.NewDefaultCustomMsgHandler(k)
+			if spec.auth != nil {
+				h = keeper.NewCustomMsgHandler(k, spec.auth)
+			}
+			bz, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+
+			// when
+			_, _, _, gotErr := h.DispatchMsg(ctx, myContractAddr, "", wasmvmtypes.CosmosMsg{Custom: bz})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRecipient, keepers.BankKeeper.GetBalance(ctx, myRecipient, denom).Amount)
+			assert.Equal(t, spec.expContract, keepers.BankKeeper.GetBalance(ctx, myContractAddr, denom).Amount)
+			var found bool
+			for _, e := range ctx.EventManager().Events() {
+				found = found || e.Type == spec.expEvent
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestIsContractAuthorized(t *testing.T) {
+	myBabylonContract := sdk.AccAddress(rand.Bytes(32))
+	myBTCStakingContract := sdk.AccAddress(rand.Bytes(32))
+	myRegisteredContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	params := k.GetParams(ctx)
+	params.BabylonContractAddress = myBabylonContract.String()
+	params.BtcStakingContractAddress = myBTCStakingContract.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetContractAuthorization(ctx, types.ContractAuthorization{
+		ContractAddress: myRegisteredContract.String(),
+		MsgTypes:        []string{types.CustomMsgTypeMintRewards},
+	}))
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		msgType  string
+		exp      bool
+	}{
+		"babylon contract": {
+			contract: myBabylonContract,
+			msgType:  types.CustomMsgTypeBurnSlashed,
+			exp:      true,
+		},
+		"btc staking contract": {
+			contract: myBTCStakingContract,
+			msgType:  types.CustomMsgTypeMintRewards,
+			exp:      true,
+		},
+		"registered contract with permission": {
+			contract: myRegisteredContract,
+			msgType:  types.CustomMsgTypeMintRewards,
+			exp:      true,
+		},
+		"registered contract without permission": {
+			contract: myRegisteredContract,
+			msgType:  types.CustomMsgTypeBurnSlashed,
+		},
+		"unknown contract": {
+			contract: myOtherContract,
+			msgType:  types.CustomMsgTypeMintRewards,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, k.IsContractAuthorized(ctx, spec.contract, spec.msgType))
+		})
+	}
+}
+
+func TestIntegrityHandler(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myOtherContractAddr := sdk.AccAddress(rand.Bytes(32))
+
+	delegateMsg := &stakingtypes.MsgDelegate{DelegatorAddress: myContractAddr.String()}
+	delegateBz, err := delegateMsg.Marshal()
+	require.NoError(t, err)
+	delegateAny, err := codectypes.NewAnyWithValue(delegateMsg)
+	require.NoError(t, err)
+	execBz, err := (&authz.MsgExec{Grantee: myContractAddr.String(), Msgs: []*codectypes.Any{delegateAny}}).Marshal()
+	require.NoError(t, err)
+
+	stakingMsg := wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{}}}
+	anyStakingMsg := wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(delegateMsg), Value: delegateBz}}
+	authzStakingMsg := wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: sdk.MsgTypeURL(&authz.MsgExec{}), Value: execBz}}
+	bankMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{}}}
+
+	specs := map[string]struct {
+		policy     types.StakingMsgPolicy
+		contract   sdk.AccAddress
+		msg        wasmvmtypes.CosmosMsg
+		expReject  bool
+		expMsgType string
+	}{
+		"staking msg - default deny": {
+			contract:   myContractAddr,
+			msg:        stakingMsg,
+			expReject:  true,
+			expMsgType: "staking",
+		},
+		"any staking msg - default deny": {
+			contract:   myContractAddr,
+			msg:        anyStakingMsg,
+			expReject:  true,
+			expMsgType: sdk.MsgTypeURL(delegateMsg),
+		},
+		"authz wrapped staking msg - default deny": {
+			contract:   myContractAddr,
+			msg:        authzStakingMsg,
+			expReject:  true,
+			expMsgType: sdk.MsgTypeURL(delegateMsg),
+		},
+		"staking msg - allowed": {
+			policy:   types.StakingMsgPolicy{AllowedContracts: []string{myContractAddr.String()}},
+			contract: myContractAddr,
+			msg:      stakingMsg,
+		},
+		"any staking msg - allowed": {
+			policy:   types.StakingMsgPolicy{AllowedContracts: []string{myContractAddr.String()}},
+			contract: myContractAddr,
+			msg:      anyStakingMsg,
+		},
+		"staking msg - default allow": {
+			policy:   types.StakingMsgPolicy{DefaultAllow: true},
+			contract: myContractAddr,
+			msg:      stakingMsg,
+		},
+		"staking msg - denied with default allow": {
+			policy:     types.StakingMsgPolicy{DefaultAllow: true, DeniedContracts: []string{myContractAddr.String()}},
+			contract:   myContractAddr,
+			msg:        stakingMsg,
+			expReject:  true,
+			expMsgType: "staking",
+		},
+		"staking msg - other contract allowed": {
+			policy:     types.StakingMsgPolicy{AllowedContracts: []string{myOtherContractAddr.String()}},
+			contract:   myContractAddr,
+			msg:        stakingMsg,
+			expReject:  true,
+			expMsgType: "staking",
+		},
+		"non staking msg": {
+			contract: myContractAddr,
+			msg:      bankMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keepers := NewTestKeepers(t)
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx
+			require.NoError(t, k.SetStakingMsgPolicy(ctx, spec.policy))
+			h := keeper.NewIntegrityHandler(k)
+
+			// when
+			_, _, _, gotErr := h.DispatchMsg(ctx, spec.contract, "", spec.msg)
+
+			// then
+			if !spec.expReject {
+				assert.ErrorIs(t, gotErr, wasmtypes.ErrUnknownMsg)
+				return
+			}
+			require.ErrorIs(t, gotErr, sdkerrors.ErrUnauthorized)
+			assert.Contains(t, gotErr.Error(), spec.contract.String())
+			assert.Contains(t, gotErr.Error(), spec.expMsgType)
+			var rejected *types.StakingMsgRejectedError
+			require.ErrorAs(t, gotErr, &rejected)
+			assert.Equal(t, types.StakingMsgRejectedError{ContractAddress: spec.contract.String(), MsgType: spec.expMsgType}, *rejected)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// IndexHeader stores the header information of the current block and prunes the
+// headers that fall out of the retention window of the HeaderRetentionBlocks param
+func (k Keeper) IndexHeader(ctx context.Context) error {
+	headerInfo := sdk.UnwrapSDKContext(ctx).HeaderInfo()
+	err := k.setIndexedHeader(ctx, types.IndexedHeader{
+		Height:  headerInfo.Height,
+		Hash:    headerInfo.Hash,
+		AppHash: headerInfo.AppHash,
+		Time:    headerInfo.Time,
+	})
+	if err != nil {
+		return err
+	}
+	// all headers up to the prune height are removed, so that lowering the retention shrinks the window
+	pruneHeight := headerInfo.Height - int64(k.GetParams(ctx).GetHeaderRetentionBlocks())
+	if pruneHeight <= 0 {
+		return nil
+	}
+	return k.IndexedHeaders.Clear(ctx, new(collections.Range[uint64]).EndInclusive(uint64(pruneHeight)))
+}
+
+func (k Keeper) setIndexedHeader(ctx context.Context, header types.IndexedHeader) error {
+	return k.IndexedHeaders.Set(ctx, uint64(header.Height), header)
+}
+
+// GetIndexedHeader returns the header information of the block at the given height
+// when it is within the retention window
+func (k Keeper) GetIndexedHeader(ctx context.Context, height int64) (types.IndexedHeader, bool) {
+	header, err := k.IndexedHeaders.Get(ctx, uint64(height))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return header, false
+	case err != nil:
+		panic(err)
+	}
+	return header, true
+}
+
+// IterateIndexedHeaders iterates over all indexed headers in ascending height order.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateIndexedHeaders(ctx context.Context, cb func(header types.IndexedHeader) bool) {
+	err := k.IndexedHeaders.Walk(ctx, nil, func(_ uint64, header types.IndexedHeader) (bool, error) {
+		return cb(header), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	coreheader "cosmossdk.io/core/header"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestIndexHeaderPruning(t *testing.T) {
+	const retention = 5
+	specs := map[string]struct {
+		height    int64
+		expHeader map[int64]bool
+	}{
+		"within retention window": {
+			height:    retention,
+			expHeader: map[int64]bool{1: true, retention: true},
+		},
+		"prunes header at height minus retention": {
+			height:    retention + 1,
+			expHeader: map[int64]bool{1: false, 2: true, retention + 1: true},
+		},
+		"keeps header at height minus retention plus one": {
+			height:    2 * retention,
+			expHeader: map[int64]bool{retention: false, retention + 1: true, 2 * retention: true},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keepers := NewTestKeepers(t)
+			k := keepers.BabylonKeeper
+			params := types.DefaultParams(sdk.DefaultBondDenom)
+			params.HeaderRetentionBlocks = retention
+			require.NoError(t, k.SetParams(keepers.Ctx, params))
+
+			// when
+			for h := int64(1); h <= spec.height; h++ {
+				ctx := keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: h, Hash: []byte{byte(h)}})
+				require.NoError(t, k.IndexHeader(ctx))
+			}
+
+			// then
+			for h, exp := range spec.expHeader {
+				_, found := k.GetIndexedHeader(keepers.Ctx, h)
+				assert.Equal(t, exp, found, "height %d", h)
+			}
+		})
+	}
+}
+
+func TestIndexHeaderLoweredRetention(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	for h := int64(1); h <= 10; h++ {
+		ctx := keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: h, Hash: []byte{byte(h)}})
+		require.NoError(t, k.IndexHeader(ctx))
+	}
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.HeaderRetentionBlocks = 3
+	require.NoError(t, k.SetParams(keepers.Ctx, params))
+
+	// when
+	require.NoError(t, k.IndexHeader(keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: 11})))
+
+	// then all headers out of the new window are pruned
+	var got []int64
+	k.IterateIndexedHeaders(keepers.Ctx, func(header types.IndexedHeader) bool {
+		got = append(got, header.Height)
+		return false
+	})
+	assert.Equal(t, []int64{9, 10, 11}, got)
+}
+
+func TestIndexHeaderDefaultRetention(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.HeaderRetentionBlocks = 0
+	require.NoError(t, k.SetParams(keepers.Ctx, params))
+	require.NoError(t, k.IndexedHeaders.Set(keepers.Ctx, 1, types.IndexedHeader{Height: 1}))
+
+	// an unset retention keeps the default number of headers
+	require.NoError(t, k.IndexHeader(keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: types.DefaultHeaderRetentionBlocks})))
+	_, found := k.GetIndexedHeader(keepers.Ctx, 1)
+	assert.True(t, found)
+
+	require.NoError(t, k.IndexHeader(keepers.Ctx.WithHeaderInfo(coreheader.Info{Height: types.DefaultHeaderRetentionBlocks + 1})))
+	_, found = k.GetIndexedHeader(keepers.Ctx, 1)
+	assert.False(t, found)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// SetHookRegistration stores the block hook subscription of a contract in the hook registry.
+// An existing registration of the contract for the same hook is replaced.
+func (k Keeper) SetHookRegistration(ctx context.Context, reg types.HookRegistration) error {
+	if err := reg.ValidateBasic(); err != nil {
+		return err
+	}
+	phase, err := types.SchedulerPhaseFromHook(reg.Hook)
+	if err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(reg.ContractAddress)
+	return k.HookRegistrations.Set(ctx, collections.Join(phase, contractAddr), reg)
+}
+
+// GetHookRegistration returns the registration of the contract for the given hook
+func (k Keeper) GetHookRegistration(ctx context.Context, contractAddr sdk.AccAddress, hook string) (types.HookRegistration, bool) {
+	phase, err := types.SchedulerPhaseFromHook(hook)
+	if err != nil {
+		return types.HookRegistration{}, false
+	}
+	reg, err := k.HookRegistrations.Get(ctx, collections.Join(phase, contractAddr))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return reg, false
+	case err != nil:
+		panic(err)
+	}
+	return reg, true
+}
+
+// RemoveHookRegistration deletes the registration of the contract for the given hook
+func (k Keeper) RemoveHookRegistration(ctx context.Context, contractAddr sdk.AccAddress, hook string) error {
+	phase, err := types.SchedulerPhaseFromHook(hook)
+	if err != nil {
+		return err
+	}
+	return k.HookRegistrations.Remove(ctx, collections.Join(phase, contractAddr))
+}
+
+// IterateHookRegistrations iterates over all hook registrations in store order, which is by hook and then
+// by contract address. Iteration stops when the callback returns true.
+func (k Keeper) IterateHookRegistrations(ctx context.Context, cb func(reg types.HookRegistration) bool) {
+	err := k.HookRegistrations.Walk(ctx, nil, func(_ collections.Pair[types.SchedulerPhase, sdk.AccAddress], reg types.HookRegistration) (bool, error) {
+		return cb(reg), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetHookRegistrations returns the registrations of the given hook in execution order: by descending
+// priority and then by contract address
+func (k Keeper) GetHookRegistrations(ctx context.Context, hook string) ([]types.HookRegistration, error) {
+	phase, err := types.SchedulerPhaseFromHook(hook)
+	if err != nil {
+		return nil, err
+	}
+	var regs []types.HookRegistration
+	rng := collections.NewPrefixedPairRange[types.SchedulerPhase, sdk.AccAddress](phase)
+	err = k.HookRegistrations.Walk(ctx, rng, func(_ collections.Pair[types.SchedulerPhase, sdk.AccAddress], reg types.HookRegistration) (bool, error) {
+		regs = append(regs, reg)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// store order is by contract address, a stable sort keeps it for equal priorities
+	sort.SliceStable(regs, func(i, j int) bool {
+		return regs[i].Priority > regs[j].Priority
+	})
+	return regs, nil
+}
@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestGetHookRegistrations(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+
+	myAddrs := []sdk.AccAddress{
+		sdk.AccAddress(append(make([]byte, 31), 1)),
+		sdk.AccAddress(append(make([]byte, 31), 2)),
+		sdk.AccAddress(append(make([]byte, 31), 3)),
+		sdk.AccAddress(append(make([]byte, 31), 4)),
+	}
+	for i, reg := range []types.HookRegistration{
+		{ContractAddress: myAddrs[3].String(), Hook: types.SudoHookBeginBlock, Priority: 1},
+		{ContractAddress: myAddrs[2].String(), Hook: types.SudoHookBeginBlock},
+		{ContractAddress: myAddrs[1].String(), Hook: types.SudoHookBeginBlock, Priority: 1},
+		{ContractAddress: myAddrs[0].String(), Hook: types.SudoHookBeginBlock, Priority: 5},
+		{ContractAddress: myAddrs[2].String(), Hook: types.SudoHookEndBlock, Priority: 7},
+	} {
+		require.NoError(t, k.SetHookRegistration(ctx, reg), "registration %d", i)
+	}
+
+	// when
+	gotBegin, err := k.GetHookRegistrations(ctx, types.SudoHookBeginBlock)
+	require.NoError(t, err)
+	gotEnd, err := k.GetHookRegistrations(ctx, types.SudoHookEndBlock)
+	require.NoError(t, err)
+
+	// then
+	var gotBeginAddrs []sdk.AccAddress
+	for _, reg := range gotBegin {
+		gotBeginAddrs = append(gotBeginAddrs, sdk.MustAccAddressFromBech32(reg.ContractAddress))
+	}
+	assert.Equal(t, []sdk.AccAddress{myAddrs[0], myAddrs[1], myAddrs[3], myAddrs[2]}, gotBeginAddrs)
+	assert.Equal(t, []types.HookRegistration{{ContractAddress: myAddrs[2].String(), Hook: types.SudoHookEndBlock, Priority: 7}}, gotEnd)
+
+	// and when replaced and removed
+	require.NoError(t, k.SetHookRegistration(ctx, types.HookRegistration{ContractAddress: myAddrs[2].String(), Hook: types.SudoHookBeginBlock, Priority: 9}))
+	require.NoError(t, k.RemoveHookRegistration(ctx, myAddrs[0], types.SudoHookBeginBlock))
+	gotBegin, err = k.GetHookRegistrations(ctx, types.SudoHookBeginBlock)
+	require.NoError(t, err)
+	gotBeginAddrs = nil
+	for _, reg := range gotBegin {
+		gotBeginAddrs = append(gotBeginAddrs, sdk.MustAccAddressFromBech32(reg.ContractAddress))
+	}
+	assert.Equal(t, []sdk.AccAddress{myAddrs[2], myAddrs[1], myAddrs[3]}, gotBeginAddrs)
+}
+
+func TestSendBlockMsgHookRegistry(t *testing.T) {
+	myBTCStakingAddr := sdk.AccAddress(rand.Bytes(32))
+	myRegisteredAddr := sdk.AccAddress(rand.Bytes(32))
+	myOtherRegisteredAddr := sdk.AccAddress(rand.Bytes(32))
+	myUnknownAddr := sdk.AccAddress(rand.Bytes(32))
+	type call struct {
+		contract sdk.AccAddress
+		hook     string
+		gasLimit storetypes.Gas
+	}
+
+	specs := map[string]struct {
+		registrations []types.HookRegistration
+		malformed     bool
+		expCalls      []call
+	}{
+		"no registrations": {
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"registrations after subscriptions in priority order": {
+			registrations: []types.HookRegistration{
+				{ContractAddress: myRegisteredAddr.String(), Hook: types.SudoHookBeginBlock, GasLimit: 50_000},
+				{ContractAddress: myOtherRegisteredAddr.String(), Hook: types.SudoHookBeginBlock, Priority: 1},
+				{ContractAddress: myRegisteredAddr.String(), Hook: types.SudoHookEndBlock},
+			},
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myOtherRegisteredAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myRegisteredAddr, hook: types.SudoHookBeginBlock, gasLimit: 50_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+				{contract: myRegisteredAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"subscribed contract called once": {
+			registrations: []types.HookRegistration{
+				{ContractAddress: myBTCStakingAddr.String(), Hook: types.SudoHookBeginBlock, Priority: 10},
+			},
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"malformed contract address skipped": {
+			malformed: true,
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+		"contract not on-chain skipped": {
+			registrations: []types.HookRegistration{
+				{ContractAddress: myUnknownAddr.String(), Hook: types.SudoHookEndBlock},
+			},
+			expCalls: []call{
+				{contract: myBTCStakingAddr, hook: types.SudoHookBeginBlock, gasLimit: 100_000},
+				{contract: myBTCStakingAddr, hook: types.SudoHookEndBlock, gasLimit: 200_000},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotCalls []call
+			mock := MockWasmKeeper{
+				HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+					return !contractAddress.Equals(myUnknownAddr)
+				},
+				SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					var sudoMsg contract.SudoMsg
+					require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+					hook := types.SudoHookBeginBlock
+					if sudoMsg.EndBlockMsg != nil {
+						hook = types.SudoHookEndBlock
+					}
+					gasLimit := sdk.UnwrapSDKContext(ctx).GasMeter().Limit()
+					gotCalls = append(gotCalls, call{contract: contractAddress, hook: hook, gasLimit: gasLimit})
+					return nil, nil
+				},
+			}
+			keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx
+
+			params := types.DefaultParams(sdk.DefaultBondDenom)
+			params.BtcStakingContractAddress = myBTCStakingAddr.String()
+			params.MaxGasBeginBlocker = 100_000
+			params.MaxGasEndBlocker = 200_000
+			require.NoError(t, k.SetParams(ctx, params))
+			for _, reg := range spec.registrations {
+				require.NoError(t, k.SetHookRegistration(ctx, reg))
+			}
+			if spec.malformed {
+				// bypass the validation, e.g. a registration written by a faulty migration
+				malformedReg := types.HookRegistration{ContractAddress: "malformed", Hook: types.SudoHookEndBlock}
+				require.NoError(t, k.HookRegistrations.Set(ctx, collections.Join(types.SchedulerPhaseEndBlock, myUnknownAddr), malformedReg))
+			}
+
+			// when
+			require.NoError(t, k.BeginBlocker(ctx))
+			_, err := k.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expCalls, gotCalls)
+		})
+	}
+}
+
+func TestMsgRegisterAndDeregisterHook(t *testing.T) {
+	myAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myUnknownAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return contractAddress.Equals(myContractAddr)
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ms := keeper.NewMsgServer(k)
+	myRegistration := types.HookRegistration{ContractAddress: myContractAddr.String(), Hook: types.SudoHookEndBlock, GasLimit: 100_000, Priority: 3}
+
+	registerSpecs := map[string]struct {
+		src    types.MsgRegisterHook
+		expErr error
+	}{
+		"valid": {
+			src: types.MsgRegisterHook{Authority: myAuthority, Registration: myRegistration},
+		},
+		"invalid authority": {
+			src:    types.MsgRegisterHook{Authority: myContractAddr.String(), Registration: myRegistration},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"invalid hook": {
+			src:    types.MsgRegisterHook{Authority: myAuthority, Registration: types.HookRegistration{ContractAddress: myContractAddr.String(), Hook: "unknown"}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"invalid contract address": {
+			src:    types.MsgRegisterHook{Authority: myAuthority, Registration: types.HookRegistration{ContractAddress: "invalid", Hook: types.SudoHookEndBlock}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"contract not on-chain": {
+			src:    types.MsgRegisterHook{Authority: myAuthority, Registration: types.HookRegistration{ContractAddress: myUnknownAddr.String(), Hook: types.SudoHookEndBlock}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+	}
+	for name, spec := range registerSpecs {
+		t.Run("register "+name, func(t *testing.T) {
+			ctx, _ := keepers.Ctx.CacheContext()
+			_, gotErr := ms.RegisterHook(ctx, &spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gotReg, found := k.GetHookRegistration(ctx, myContractAddr, spec.src.Registration.Hook)
+			require.True(t, found)
+			assert.Equal(t, spec.src.Registration, gotReg)
+		})
+	}
+
+	deregisterSpecs := map[string]struct {
+		src    types.MsgDeregisterHook
+		expErr error
+	}{
+		"valid": {
+			src: types.MsgDeregisterHook{Authority: myAuthority, ContractAddress: myContractAddr.String(), Hook: types.SudoHookEndBlock},
+		},
+		"invalid authority": {
+			src:    types.MsgDeregisterHook{Authority: myContractAddr.String(), ContractAddress: myContractAddr.String(), Hook: types.SudoHookEndBlock},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"invalid hook": {
+			src:    types.MsgDeregisterHook{Authority: myAuthority, ContractAddress: myContractAddr.String(), Hook: "unknown"},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"not registered": {
+			src:    types.MsgDeregisterHook{Authority: myAuthority, ContractAddress: myContractAddr.String(), Hook: types.SudoHookBeginBlock},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range deregisterSpecs {
+		t.Run("deregister "+name, func(t *testing.T) {
+			ctx, _ := keepers.Ctx.CacheContext()
+			require.NoError(t, k.SetHookRegistration(ctx, myRegistration))
+			_, gotErr := ms.DeregisterHook(ctx, &spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			_, found := k.GetHookRegistration(ctx, myContractAddr, spec.src.Hook)
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestQueryHookRegistrations(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	q := keeper.NewQuerier(keepers.EncodingConfig.Marshaler, k)
+	myBeginReg := types.HookRegistration{ContractAddress: sdk.AccAddress(rand.Bytes(32)).String(), Hook: types.SudoHookBeginBlock}
+	myEndReg := types.HookRegistration{ContractAddress: sdk.AccAddress(rand.Bytes(32)).String(), Hook: types.SudoHookEndBlock, Priority: 2}
+	require.NoError(t, k.SetHookRegistration(ctx, myBeginReg))
+	require.NoError(t, k.SetHookRegistration(ctx, myEndReg))
+
+	specs := map[string]struct {
+		src    *types.QueryHookRegistrationsRequest
+		exp    []types.HookRegistration
+		expErr bool
+	}{
+		"all": {
+			src: &types.QueryHookRegistrationsRequest{},
+			exp: []types.HookRegistration{myBeginReg, myEndReg},
+		},
+		"by hook": {
+			src: &types.QueryHookRegistrationsRequest{Hook: types.SudoHookEndBlock},
+			exp: []types.HookRegistration{myEndReg},
+		},
+		"invalid hook": {
+			src:    &types.QueryHookRegistrationsRequest{Hook: "unknown"},
+			expErr: true,
+		},
+		"empty request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := q.HookRegistrations(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotRes.Registrations)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// GetHookStatus returns the block hook status of the given contract.
+// A contract without any recorded failure has the zero value status.
+func (k Keeper) GetHookStatus(ctx context.Context, contractAddr sdk.AccAddress) types.HookStatus {
+	status, err := k.HookStatuses.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return status
+}
+
+func (k Keeper) setHookStatus(ctx context.Context, contractAddr sdk.AccAddress, status types.HookStatus) {
+	var err error
+	if status.Equal(types.HookStatus{}) {
+		err = k.HookStatuses.Remove(ctx, contractAddr)
+	} else {
+		err = k.HookStatuses.Set(ctx, contractAddr, status)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsHookSuspended returns true when the circuit breaker suspended the block hooks of the contract
+func (k Keeper) IsHookSuspended(ctx context.Context, contractAddr sdk.AccAddress) bool {
+	return k.GetHookStatus(ctx, contractAddr).Suspended
+}
+
+// ResumeHooks clears the failure counter and suspended status of the contract's block hooks
+func (k Keeper) ResumeHooks(ctx context.Context, contractAddr sdk.AccAddress) {
+	k.setHookStatus(ctx, contractAddr, types.HookStatus{})
+	types.EmitHooksResumedEvent(ctx, contractAddr)
+}
+
+// recordHookResult updates the consecutive failure counter of the contract and suspends its
+// block hooks once the MaxConsecutiveHookFailures threshold is reached.
+func (k Keeper) recordHookResult(ctx context.Context, contractAddr sdk.AccAddress, err error) {
+	status := k.GetHookStatus(ctx, contractAddr)
+	if err == nil {
+		if status.ConsecutiveFailures != 0 {
+			status.ConsecutiveFailures = 0
+			k.setHookStatus(ctx, contractAddr, status)
+		}
+		return
+	}
+
+	status.ConsecutiveFailures++
+	threshold := k.GetParams(ctx).MaxConsecutiveHookFailures
+	if threshold != 0 && status.ConsecutiveFailures >= uint64(threshold) && !status.Suspended {
+		status.Suspended = true
+		status.SuspendedAtHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		k.Logger(ctx).Error("block hooks suspended", "contract", contractAddr.String(), "consecutive_failures", status.ConsecutiveFailures)
+		types.EmitHooksSuspendedEvent(ctx, contractAddr, status.ConsecutiveFailures)
+	}
+	k.setHookStatus(ctx, contractAddr, status)
+}
+
+// recordValsetUpdateResult updates the consecutive validator set update failure counter of the contract.
+// Unlike the block hook failures, these never suspend the contract.
+func (k Keeper) recordValsetUpdateResult(ctx context.Context, contractAddr sdk.AccAddress, err error) {
+	status := k.GetHookStatus(ctx, contractAddr)
+	switch {
+	case err != nil:
+		status.ConsecutiveValsetUpdateFailures++
+	case status.ConsecutiveValsetUpdateFailures != 0:
+		status.ConsecutiveValsetUpdateFailures = 0
+	default:
+		return
+	}
+	k.setHookStatus(ctx, contractAddr, status)
+}
+
+// IterateHookStatuses iterates over the block hook statuses of all contracts with recorded failures
+// in the order of the contract addresses. Iteration stops when the callback returns true.
+func (k Keeper) IterateHookStatuses(ctx context.Context, cb func(contractAddr sdk.AccAddress, status types.HookStatus) bool) {
+	err := k.HookStatuses.Walk(ctx, nil, func(contractAddr sdk.AccAddress, status types.HookStatus) (bool, error) {
+		return cb(contractAddr, status), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// hookTarget is a contract that receives a block hook sudo callback
+type hookTarget struct {
+	contractAddr sdk.AccAddress
+	// gasLimit is the maximum gas of a callback to the contract
+	gasLimit storetypes.Gas
+	// pinnedChecksums are the code checksums that the contract is pinned to, empty when it is not pinned
+	pinnedChecksums [][]byte
+	// checksum is the code checksum that a pinned contract runs, nil when it is looked up on each call
+	checksum []byte
+	// blockInfo is true when the contract opted into the block information of the BeginBlock and EndBlock hooks
+	blockInfo bool
+}
+
+// hookTargets are the contracts configured in the params that receive the block hook sudo callbacks
+type hookTargets struct {
+	// beginBlock and endBlock are the targets of the block hooks in subscription order
+	beginBlock []hookTarget
+	endBlock   []hookTarget
+	// valsetUpdate is the BTC staking contract when it is subscribed to the validator set updates
+	valsetUpdate *hookTarget
+}
+
+// forHook returns the targets of the BeginBlock or EndBlock hook
+func (t hookTargets) forHook(hook string) []hookTarget {
+	switch hook {
+	case types.SudoHookBeginBlock:
+		return t.beginBlock
+	case types.SudoHookEndBlock:
+		return t.endBlock
+	default:
+		return nil
+	}
+}
+
+// hookTargetsCache holds the hook targets resolved for a block. The entry is bound to the height and hash
+// of the block so that it is never reused for another block, including a discarded proposal at the same
+// height. It is not part of the state and must be invalidated whenever the params or the code pins change
+// or a contract is migrated. It is kept in process memory rather than in a memory store, as migrations are
+// only observed by the wasm engine, which has no context to write to a store.
+type hookTargetsCache struct {
+	mu        sync.Mutex
+	height    int64
+	blockHash []byte
+	targets   *hookTargets
+}
+
+func newHookTargetsCache() *hookTargetsCache {
+	return &hookTargetsCache{}
+}
+
+func (c *hookTargetsCache) get(height int64, blockHash []byte) (hookTargets, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.targets == nil || c.height != height || !bytes.Equal(c.blockHash, blockHash) {
+		return hookTargets{}, false
+	}
+	return *c.targets, true
+}
+
+func (c *hookTargetsCache) set(height int64, blockHash []byte, targets hookTargets) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.height, c.blockHash, c.targets = height, blockHash, &targets
+}
+
+func (c *hookTargetsCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.targets = nil
+}
+
+// getHookTargets returns the contracts configured in the params that receive the block hook sudo
+// callbacks. The targets are resolved at most once per block and cached, so that the BeginBlock,
+// EndBlock and validator set update hooks do not decode the params and look up the contracts again.
+// Targets with a configured contract that is malformed or not on-chain are not cached and resolved
+// again on the next call, so that a contract instantiated later is picked up.
+func (k Keeper) getHookTargets(ctx context.Context) hookTargets {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, blockHash := sdkCtx.BlockHeight(), sdkCtx.HeaderInfo().Hash
+	if targets, ok := k.hookTargets.get(height, blockHash); ok {
+		return targets
+	}
+	targets, complete := k.resolveHookTargets(ctx)
+	if complete {
+		k.hookTargets.set(height, blockHash, targets)
+	}
+	return targets
+}
+
+// resolveHookTargets resolves the block hook targets from the params and code pins. It returns
+// false when a configured contract could not be resolved.
+func (k Keeper) resolveHookTargets(ctx context.Context) (hookTargets, bool) {
+	params := k.GetParams(ctx)
+	pins := k.GetCodePins(ctx)
+	var targets hookTargets
+	complete := true
+	resolve := func(contractName string) sdk.AccAddress {
+		addr := k.getContractAddr(ctx, params, contractName)
+		if addr == nil && len(params.ContractAddress(contractName)) != 0 {
+			complete = false
+		}
+		return addr
+	}
+
+	for _, sub := range params.GetHookSubscriptions() {
+		addr := resolve(sub.Contract)
+		if addr == nil {
+			continue
+		}
+		target := hookTarget{contractAddr: addr, pinnedChecksums: contractPins(params, pins, addr)}
+		if len(target.pinnedChecksums) != 0 {
+			// the code of a pinned contract is resolved once, a migration drops the cached targets
+			checksum, err := k.GetContractChecksum(ctx, addr)
+			if err != nil {
+				complete = false
+			}
+			target.checksum = checksum
+		}
+		if sub.Subscribes(types.SudoHookBeginBlock) {
+			beginBlock := target
+			beginBlock.gasLimit = hookGasLimit(params, types.SudoHookBeginBlock, sub.GasLimit)
+			beginBlock.blockInfo = sub.BlockInfo
+			targets.beginBlock = append(targets.beginBlock, beginBlock)
+		}
+		if sub.Subscribes(types.SudoHookEndBlock) {
+			endBlock := target
+			endBlock.gasLimit = hookGasLimit(params, types.SudoHookEndBlock, sub.GasLimit)
+			endBlock.blockInfo = sub.BlockInfo
+			targets.endBlock = append(targets.endBlock, endBlock)
+		}
+		// subscriptions are validated to only subscribe the BTC staking contract to the validator set updates
+		if sub.Subscribes(types.SudoHookValsetUpdate) && targets.valsetUpdate == nil {
+			// the validator set update is sent at the end of the block
+			valsetUpdate := target
+			valsetUpdate.gasLimit = hookGasLimit(params, types.SudoHookEndBlock, sub.GasLimit)
+			targets.valsetUpdate = &valsetUpdate
+		}
+	}
+	return targets, complete
+}
+
+// invalidateHookTargets drops the cached block hook targets. It must be called whenever the params
+// or the code pins that the targets are resolved from change or a contract is migrated.
+func (k Keeper) invalidateHookTargets() {
+	k.hookTargets.invalidate()
+}
+
+// NewWasmEngineDecorator returns the wasm engine decorator that drops the cached block hook targets
+// whenever a contract is migrated, so that the new code of a pinned contract is checked again.
+func NewWasmEngineDecorator(k *Keeper) func(wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+	return func(engine wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+		return migrationObserver{WasmEngine: engine, k: k}
+	}
+}
+
+// migrationObserver is a wasm engine that invalidates the block hook targets on contract migrations
+type migrationObserver struct {
+	wasmtypes.WasmEngine
+	k *Keeper
+}
+
+// Migrate migrates the contract with the decorated engine and drops the cached block hook targets.
+// Targets are also dropped when the migration fails or is reverted, which only costs a lookup.
+func (e migrationObserver) Migrate(
+	checksum wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	migrateMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.ContractResult, uint64, error) {
+	defer e.k.invalidateHookTargets()
+	return e.WasmEngine.Migrate(checksum, env, migrateMsg, store, goapi, querier, gasMeter, gasLimit, deserCost)
+}
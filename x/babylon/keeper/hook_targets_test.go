@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	coreheader "cosmossdk.io/core/header"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestHookTargetsCache(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	var onChain bool
+	var lookups, sudoCalls int
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			lookups++
+			return onChain && contractAddress.Equals(myContractAddr)
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			sudoCalls++
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(10)
+
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.HookSubscriptions = []types.HookSubscription{
+		{Contract: types.ContractBTCStaking, BeginBlock: true, EndBlock: true, ValsetUpdate: true},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	runBlock := func(ctx sdk.Context) {
+		t.Helper()
+		require.NoError(t, k.BeginBlocker(ctx))
+		_, err := k.EndBlocker(ctx)
+		require.NoError(t, err)
+	}
+
+	// a contract that is not on-chain is looked up on every hook and validator set update
+	runBlock(ctx)
+	assert.Equal(t, 3, lookups)
+	assert.Equal(t, 0, sudoCalls)
+
+	// and picked up as soon as it is instantiated
+	onChain = true
+	lookups = 0
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, lookups)
+	assert.Equal(t, 1, sudoCalls)
+
+	// resolved targets are reused within the block
+	runBlock(ctx)
+	assert.Equal(t, 1, lookups)
+	assert.Equal(t, 3, sudoCalls)
+
+	// and resolved again in the next block
+	ctx = ctx.WithBlockHeight(11)
+	runBlock(ctx)
+	assert.Equal(t, 2, lookups)
+	runBlock(ctx)
+	assert.Equal(t, 2, lookups)
+
+	// another block at the same height, e.g. after a discarded proposal, resolves them again
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Height: 11, Hash: []byte("other block")})
+	runBlock(ctx)
+	assert.Equal(t, 3, lookups)
+
+	// changing the params invalidates the targets
+	params.MaxGasEndBlocker++
+	require.NoError(t, k.SetParams(ctx, params))
+	runBlock(ctx)
+	assert.Equal(t, 4, lookups)
+
+	// changing the code pins invalidates the targets
+	require.NoError(t, k.SetCodePins(ctx, types.CodePins{BabylonContractChecksums: [][]byte{bytes.Repeat([]byte{1}, 32)}}))
+	runBlock(ctx)
+	assert.Equal(t, 5, lookups)
+
+	// and so does the store migration
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	runBlock(ctx)
+	assert.Equal(t, 6, lookups)
+}
+
+func TestHookTargetsInvalidatedOnMigration(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myPinnedChecksum, myOtherChecksum := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	checksum := myPinnedChecksum
+	var codeLookups, sudoCalls int
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+			codeLookups++
+			return &wasmtypes.ContractInfo{CodeID: 1}
+		},
+		GetCodeInfoFn: func(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo {
+			return &wasmtypes.CodeInfo{CodeHash: checksum}
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			sudoCalls++
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(10)
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetCodePins(ctx, types.CodePins{BtcStakingContractChecksums: [][]byte{myPinnedChecksum}}))
+
+	// the code of a pinned contract is resolved with the targets
+	require.NoError(t, k.BeginBlocker(ctx))
+	assert.Equal(t, 1, sudoCalls)
+	assert.Equal(t, 1, codeLookups)
+
+	// when the contract is migrated to unpinned code within the block
+	checksum = myOtherChecksum
+	engine := keeper.NewWasmEngineDecorator(k)(&wasmtesting.MockWasmEngine{
+		MigrateFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+		},
+	})
+	_, _, err := engine.Migrate(myOtherChecksum, wasmvmtypes.Env{}, nil, nil, wasmvm.GoAPI{}, nil, nil, 0, wasmvmtypes.UFraction{})
+	require.NoError(t, err)
+
+	// then the targets are resolved again and the new code is refused
+	_, err = k.EndBlocker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, codeLookups)
+	assert.Equal(t, 1, sudoCalls)
+}
+
+// BenchmarkBlockHooks measures the overhead of the block hooks of a block with a subscribed BTC staking
+// contract. Resolving the targets per hook is the cost of a block without the hook targets cache.
+func BenchmarkBlockHooks(b *testing.B) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	specs := map[string]struct {
+		resolvePerHook bool
+	}{
+		"resolve per block": {},
+		"resolve per hook":  {resolvePerHook: true},
+	}
+	for name, spec := range specs {
+		b.Run(name, func(b *testing.B) {
+			keepers := NewTestKeepers(b, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			params := types.DefaultParams(sdk.DefaultBondDenom)
+			params.BtcStakingContractAddress = myContractAddr.String()
+			params.HookSubscriptions = []types.HookSubscription{
+				{Contract: types.ContractBTCStaking, BeginBlock: true, EndBlock: true, ValsetUpdate: true},
+			}
+			require.NoError(b, k.SetParams(keepers.Ctx, params))
+			hooks := []func(context.Context) error{k.SendBeginBlockMsg, k.SendEndBlockMsg, k.SendValsetUpdate}
+			height := keepers.Ctx.BlockHeight()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				height++
+				ctx := keepers.Ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+				for _, hook := range hooks {
+					if spec.resolvePerHook {
+						// a new height drops the cached targets
+						height++
+						ctx = ctx.WithBlockHeight(height)
+					}
+					if err := hook(ctx); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,37 +18,84 @@ type Option interface {
 }
 
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	memKey   storetypes.StoreKey
-	cdc      codec.Codec
-	bank     types.BankKeeper
-	Staking  types.StakingKeeper
-	wasm     types.WasmKeeper
+	storeService store.KVStoreService
+	cdc          codec.Codec
+	bank         types.BankKeeper
+	Staking      types.StakingKeeper
+	wasm         types.WasmKeeper
+	// optional, tombstoned validators are not reported without it
+	slashing types.SlashingKeeper
+	// optional, contracts can not be instantiated by the module without it
+	contractOps types.ContractOpsKeeper
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+	// hookTargets caches the block hook targets of the current block
+	hookTargets *hookTargetsCache
+
+	Schema                 collections.Schema
+	Params                 collections.Item[types.Params]
+	HookStatuses           collections.Map[sdk.AccAddress, types.HookStatus]
+	IndexedHeaders         collections.Map[uint64, types.IndexedHeader]
+	ContractAuthorizations collections.Map[sdk.AccAddress, types.ContractAuthorization]
+	StakingMsgPolicy       collections.Item[types.StakingMsgPolicy]
+	// ScheduledTasks is the scheduler queue keyed by phase, height and contract address
+	ScheduledTasks     collections.Map[collections.Triple[types.SchedulerPhase, uint64, sdk.AccAddress], types.ScheduledWork]
+	ConsumerValidators collections.Map[sdk.ValAddress, types.ConsumerValidator]
+	CodePins           collections.Item[types.CodePins]
+	HookRegistrations  collections.Map[collections.Pair[types.SchedulerPhase, sdk.AccAddress], types.HookRegistration]
+	// MintedRewards is the amount of the rewards denom minted by contracts keyed by block height.
+	// Only the entry of the current block is kept.
+	MintedRewards collections.Map[uint64, uint64]
 }
 
 // NewKeeper constructor with vanilla sdk keepers
 func NewKeeper(
 	cdc codec.Codec,
-	storeKey storetypes.StoreKey,
-	memoryStoreKey storetypes.StoreKey,
+	storeService store.KVStoreService,
 	bank types.BankKeeper,
 	staking types.StakingKeeper,
 	wasm types.WasmKeeper,
 	authority string,
 	opts ...Option,
 ) *Keeper {
+	// addresses are length prefixed to keep the layout of the keys written before the collections schema
+	accAddrKey := sdk.LengthPrefixedAddressKey(sdk.AccAddressKey) //nolint:staticcheck // legacy key layout
+	valAddrKey := sdk.LengthPrefixedAddressKey(sdk.ValAddressKey) //nolint:staticcheck // legacy key layout
+	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		storeKey:  storeKey,
-		memKey:    memoryStoreKey,
-		cdc:       cdc,
-		bank:      bank,
-		Staking:   staking,
-		wasm:      wasm,
-		authority: authority,
+		storeService: storeService,
+		cdc:          cdc,
+		bank:         bank,
+		Staking:      staking,
+		wasm:         wasm,
+		authority:    authority,
+		hookTargets:  newHookTargetsCache(),
+
+		Params: collections.NewItem(sb, collections.NewPrefix(types.ParamsKey), "params", codec.CollValue[types.Params](cdc)),
+		HookStatuses: collections.NewMap(sb, collections.NewPrefix(types.HookStatusKeyPrefix), "hook_statuses",
+			accAddrKey, codec.CollValue[types.HookStatus](cdc)),
+		IndexedHeaders: collections.NewMap(sb, collections.NewPrefix(types.IndexedHeaderKeyPrefix), "indexed_headers",
+			collections.Uint64Key, codec.CollValue[types.IndexedHeader](cdc)),
+		ContractAuthorizations: collections.NewMap(sb, collections.NewPrefix(types.ContractAuthorizationKeyPrefix), "contract_authorizations",
+			accAddrKey, codec.CollValue[types.ContractAuthorization](cdc)),
+		StakingMsgPolicy: collections.NewItem(sb, collections.NewPrefix(types.StakingMsgPolicyKey), "staking_msg_policy",
+			codec.CollValue[types.StakingMsgPolicy](cdc)),
+		ScheduledTasks: collections.NewMap(sb, collections.NewPrefix(types.SchedulerKeyPrefix), "scheduled_tasks",
+			collections.TripleKeyCodec(types.SchedulerPhaseKey, collections.Uint64Key, accAddrKey), codec.CollValue[types.ScheduledWork](cdc)),
+		ConsumerValidators: collections.NewMap(sb, collections.NewPrefix(types.ValidatorSetKeyPrefix), "consumer_validators",
+			valAddrKey, codec.CollValue[types.ConsumerValidator](cdc)),
+		CodePins: collections.NewItem(sb, collections.NewPrefix(types.CodePinsKey), "code_pins", codec.CollValue[types.CodePins](cdc)),
+		HookRegistrations: collections.NewMap(sb, collections.NewPrefix(types.HookRegistrationKeyPrefix), "hook_registrations",
+			collections.PairKeyCodec(types.SchedulerPhaseKey, accAddrKey), codec.CollValue[types.HookRegistration](cdc)),
+		MintedRewards: collections.NewMap(sb, collections.NewPrefix(types.MintedRewardsKeyPrefix), "minted_rewards",
+			collections.Uint64Key, collections.Uint64Value),
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
 	for _, o := range opts {
 		o.apply(k)
 	}
@@ -59,10 +108,6 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
-func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
-}
-
-func (k Keeper) GetTest(ctx sdk.Context, actor sdk.AccAddress) string {
-	return "placeholder"
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -1,15 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
+	coreheader "cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
 	evidencetypes "cosmossdk.io/x/evidence/types"
 	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/tx/signing"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -49,11 +54,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
@@ -79,13 +86,23 @@ var moduleBasics = module.NewBasicManager(
 
 func makeEncodingConfig(_ testing.TB) encodingConfig {
 	amino := codec.NewLegacyAmino()
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          authcodec.NewBech32Codec(sdk.Bech32MainPrefix),
+			ValidatorAddressCodec: authcodec.NewBech32Codec(sdk.Bech32PrefixValAddr),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
 	std.RegisterInterfaces(interfaceRegistry)
 	std.RegisterLegacyAminoCodec(amino)
 
 	moduleBasics.RegisterLegacyAminoCodec(amino)
 	moduleBasics.RegisterInterfaces(interfaceRegistry)
+	wasmtypes.RegisterInterfaces(interfaceRegistry)
 	// add babylon types
 	types.RegisterInterfaces(interfaceRegistry)
 	types.RegisterLegacyAminoCodec(amino)
@@ -127,7 +144,7 @@ func NewTestKeepers(t testing.TB, opts ...keeper.Option) TestKeepers {
 	for _, v := range keys {
 		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
 	}
-	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, types.MemStoreKey)
+	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 	for _, v := range memKeys {
 		ms.MountStoreWithDB(v, storetypes.StoreTypeMemory, db)
 	}
@@ -168,6 +185,10 @@ func NewTestKeepers(t testing.TB, opts ...keeper.Option) TestKeepers {
 		Height: 1234567,
 		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
 	}, false, log.NewNopLogger())
+	ctx = ctx.WithHeaderInfo(coreheader.Info{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
 
 	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
@@ -267,21 +288,24 @@ func NewTestKeepers(t testing.TB, opts ...keeper.Option) TestKeepers {
 		querier,
 		t.TempDir(),
 		wasmtypes.DefaultWasmConfig(),
-		[]string{"iterator", "staking", "stargate", "cosmwasm_1_1", "cosmwasm_1_2", "cosmwasm_1_3", "virtual_staking"},
+		[]string{"iterator", "staking", "stargate", "cosmwasm_1_1", "cosmwasm_1_2", "cosmwasm_1_3", "cosmwasm_1_4", "cosmwasm_2_0", "virtual_staking"},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	require.NoError(t, wasmKeeper.SetParams(ctx, wasmtypes.DefaultParams()))
 	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&wasmKeeper)
+	wasmtypes.RegisterMsgServer(msgRouter, wasmMsgServer)
 
 	babylonKeeper := keeper.NewKeeper(
 		appCodec,
-		keys[types.StoreKey],
-		memKeys[types.MemStoreKey],
+		runtime.NewKVStoreService(keys[types.StoreKey]),
 		bankKeeper,
 		stakingKeeper,
 		wasmKeeper,
 		authority,
-		opts...,
+		append([]keeper.Option{
+			keeper.WithSlashingKeeper(slashingKeeper),
+			keeper.WithContractOpsKeeper(wasmkeeper.NewGovPermissionKeeper(&wasmKeeper)),
+		}, opts...)...,
 	)
 	require.NoError(t, babylonKeeper.SetParams(ctx, types.DefaultParams(sdk.DefaultBondDenom)))
 
@@ -300,3 +324,79 @@ func NewTestKeepers(t testing.TB, opts ...keeper.Option) TestKeepers {
 		Faucet:         faucet,
 	}
 }
+
+func TestStoreLayout(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k, ctx := keepers.BabylonKeeper, keepers.Ctx
+	myContractAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	myValAddr := sdk.ValAddress(bytes.Repeat([]byte{2}, 20))
+
+	specs := map[string]struct {
+		set    func(ctx context.Context) error
+		expKey []byte
+	}{
+		"params": {
+			set:    func(ctx context.Context) error { return k.Params.Set(ctx, types.DefaultParams(sdk.DefaultBondDenom)) },
+			expKey: types.ParamsKey,
+		},
+		"hook status": {
+			set: func(ctx context.Context) error {
+				return k.HookStatuses.Set(ctx, myContractAddr, types.HookStatus{ConsecutiveFailures: 1})
+			},
+			expKey: types.BuildHookStatusKey(myContractAddr),
+		},
+		"indexed header": {
+			set:    func(ctx context.Context) error { return k.IndexedHeaders.Set(ctx, 7, types.IndexedHeader{Height: 7}) },
+			expKey: types.BuildIndexedHeaderKey(7),
+		},
+		"contract authorization": {
+			set: func(ctx context.Context) error {
+				return k.ContractAuthorizations.Set(ctx, myContractAddr, types.ContractAuthorization{ContractAddress: myContractAddr.String()})
+			},
+			expKey: types.BuildContractAuthorizationKey(myContractAddr),
+		},
+		"staking msg policy": {
+			set:    func(ctx context.Context) error { return k.StakingMsgPolicy.Set(ctx, types.DefaultStakingMsgPolicy()) },
+			expKey: types.StakingMsgPolicyKey,
+		},
+		"scheduled task": {
+			set: func(ctx context.Context) error {
+				return k.ScheduledTasks.Set(ctx, collections.Join3(types.SchedulerPhaseEndBlock, uint64(9), myContractAddr), types.ScheduledWork{})
+			},
+			expKey: types.BuildSchedulerKey(types.SchedulerPhaseEndBlock, 9, myContractAddr),
+		},
+		"consumer validator": {
+			set: func(ctx context.Context) error {
+				return k.ConsumerValidators.Set(ctx, myValAddr, types.ConsumerValidator{OperatorAddress: myValAddr.String()})
+			},
+			expKey: types.BuildValidatorSetKey(myValAddr),
+		},
+		"code pins": {
+			set:    func(ctx context.Context) error { return k.CodePins.Set(ctx, types.CodePins{}) },
+			expKey: types.CodePinsKey,
+		},
+		"hook registration": {
+			set: func(ctx context.Context) error {
+				return k.HookRegistrations.Set(ctx, collections.Join(types.SchedulerPhaseBeginBlock, myContractAddr), types.HookRegistration{})
+			},
+			expKey: types.BuildHookRegistrationKey(types.SchedulerPhaseBeginBlock, myContractAddr),
+		},
+		"minted rewards": {
+			set:    func(ctx context.Context) error { return k.MintedRewards.Set(ctx, 11, 100) },
+			expKey: types.BuildMintedRewardsKey(11),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			store := ctx.KVStore(keepers.StoreKey)
+			store.Delete(spec.expKey)
+
+			// when
+			require.NoError(t, spec.set(ctx))
+
+			// then
+			assert.True(t, store.Has(spec.expKey))
+		})
+	}
+}
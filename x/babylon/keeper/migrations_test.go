@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+
+	// v1 store with the params only
+	store := ctx.KVStore(keepers.StoreKey)
+	store.Delete(types.StakingMsgPolicyKey)
+	store.Delete(types.CodePinsKey)
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxGasBeginBlocker: 400_000}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	assert.Equal(t, uint32(400_000), params.MaxGasBeginBlocker)
+	assert.Equal(t, uint32(400_000), params.MaxGasEndBlocker)
+	assert.True(t, store.Has(types.StakingMsgPolicyKey))
+	assert.True(t, store.Has(types.CodePinsKey))
+}
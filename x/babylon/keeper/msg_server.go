@@ -21,7 +21,7 @@ func NewMsgServer(k *Keeper) *msgServer {
 }
 
 // UpdateParams updates the params.
-func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
 	}
@@ -29,10 +29,188 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.k.ValidateContracts(ctx, req.Params); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid contract: %v", err)
+	}
 	if err := ms.k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ResumeHooks clears the suspended status of the block hooks of a contract.
+func (ms msgServer) ResumeHooks(ctx context.Context, req *types.MsgResumeHooks) (*types.MsgResumeHooksResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid contract address: %v", err)
+	}
+
+	ms.k.ResumeHooks(ctx, contractAddr)
+
+	return &types.MsgResumeHooksResponse{}, nil
+}
+
+// SetContractAuthorization creates or replaces the custom message authorization of a contract.
+func (ms msgServer) SetContractAuthorization(ctx context.Context, req *types.MsgSetContractAuthorization) (*types.MsgSetContractAuthorizationResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	if err := req.Authorization.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid authorization: %v", err)
+	}
+
+	if err := ms.k.SetContractAuthorization(ctx, req.Authorization); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractAuthorizationResponse{}, nil
+}
+
+// RemoveContractAuthorization revokes the custom message authorization of a contract.
+func (ms msgServer) RemoveContractAuthorization(ctx context.Context, req *types.MsgRemoveContractAuthorization) (*types.MsgRemoveContractAuthorizationResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid contract address: %v", err)
+	}
+
+	if _, found := ms.k.GetContractAuthorization(ctx, contractAddr); !found {
+		return nil, types.ErrNotFound.Wrapf("authorization of contract %s", req.ContractAddress)
+	}
+	if err := ms.k.RemoveContractAuthorization(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveContractAuthorizationResponse{}, nil
+}
+
+// UpdateStakingMsgPolicy replaces the policy that controls which contracts may dispatch staking messages.
+func (ms msgServer) UpdateStakingMsgPolicy(ctx context.Context, req *types.MsgUpdateStakingMsgPolicy) (*types.MsgUpdateStakingMsgPolicyResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	if err := req.Policy.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid policy: %v", err)
+	}
+
+	if err := ms.k.SetStakingMsgPolicy(ctx, req.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateStakingMsgPolicyResponse{}, nil
+}
+
+// InstantiateBabylonContracts instantiates the Babylon and BTC staking contracts and stores their addresses in the params.
+func (ms msgServer) InstantiateBabylonContracts(ctx context.Context, req *types.MsgInstantiateBabylonContracts) (*types.MsgInstantiateBabylonContractsResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	if req.BabylonContractCodeId == 0 {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("empty babylon contract code id")
+	}
+	if req.BtcStakingContractCodeId == 0 {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("empty btc staking contract code id")
+	}
+	if len(req.BabylonInitMsg) != 0 {
+		if err := req.BabylonInitMsg.ValidateBasic(); err != nil {
+			return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid babylon init msg: %s", err)
+		}
+	}
+	if len(req.BtcStakingInitMsg) != 0 {
+		if err := req.BtcStakingInitMsg.ValidateBasic(); err != nil {
+			return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid btc staking init msg: %s", err)
+		}
+	}
+	var admin sdk.AccAddress
+	if len(req.Admin) != 0 {
+		var err error
+		if admin, err = sdk.AccAddressFromBech32(req.Admin); err != nil {
+			return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid admin address: %s", err)
+		}
+	}
+
+	babylonAddr, btcStakingAddr, err := ms.k.InstantiateBabylonContracts(
+		ctx,
+		req.BabylonContractCodeId,
+		req.BtcStakingContractCodeId,
+		admin,
+		req.BabylonInitMsg,
+		req.BtcStakingInitMsg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantiateBabylonContractsResponse{
+		BabylonContractAddress:    babylonAddr.String(),
+		BtcStakingContractAddress: btcStakingAddr.String(),
+	}, nil
+}
+
+// UpdateCodePins replaces the code checksums that the Babylon contracts are pinned to.
+func (ms msgServer) UpdateCodePins(ctx context.Context, req *types.MsgUpdateCodePins) (*types.MsgUpdateCodePinsResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	if err := req.Pins.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid code pins: %v", err)
+	}
+
+	if err := ms.k.SetCodePins(ctx, req.Pins); err != nil {
+		return nil, err
+	}
+	types.EmitCodePinsUpdatedEvent(ctx, req.Pins)
+
+	return &types.MsgUpdateCodePinsResponse{}, nil
+}
+
+// RegisterHook adds or replaces the block hook subscription of a contract in the hook registry.
+func (ms msgServer) RegisterHook(ctx context.Context, req *types.MsgRegisterHook) (*types.MsgRegisterHookResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	if err := req.Registration.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid registration: %v", err)
+	}
+
+	contractAddr := sdk.MustAccAddressFromBech32(req.Registration.ContractAddress)
+	if !ms.k.wasm.HasContractInfo(ctx, contractAddr) {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("contract not found: %s", req.Registration.ContractAddress)
+	}
+	if err := ms.k.SetHookRegistration(ctx, req.Registration); err != nil {
+		return nil, err
+	}
+	types.EmitHookRegisteredEvent(ctx, req.Registration)
+
+	return &types.MsgRegisterHookResponse{}, nil
+}
+
+// DeregisterHook removes the block hook subscription of a contract from the hook registry.
+func (ms msgServer) DeregisterHook(ctx context.Context, req *types.MsgDeregisterHook) (*types.MsgDeregisterHookResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid contract address: %s", err)
+	}
+	if _, err := types.SchedulerPhaseFromHook(req.Hook); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid hook: %s", err)
+	}
+
+	if _, found := ms.k.GetHookRegistration(ctx, contractAddr, req.Hook); !found {
+		return nil, types.ErrNotFound.Wrapf("hook registration: %s %s", req.ContractAddress, req.Hook)
+	}
+	if err := ms.k.RemoveHookRegistration(ctx, contractAddr, req.Hook); err != nil {
+		return nil, err
+	}
+	types.EmitHookDeregisteredEvent(ctx, contractAddr, req.Hook)
+
+	return &types.MsgDeregisterHookResponse{}, nil
+}
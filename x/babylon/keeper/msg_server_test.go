@@ -1 +1,259 @@
-package keeper
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestMsgResumeHooks(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+
+	specs := map[string]struct {
+		src    types.MsgResumeHooks
+		expErr error
+	}{
+		"valid": {
+			src: types.MsgResumeHooks{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ContractAddress: myContractAddr.String(),
+			},
+		},
+		"invalid authority": {
+			src: types.MsgResumeHooks{
+				Authority:       myContractAddr.String(),
+				ContractAddress: myContractAddr.String(),
+			},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"invalid contract address": {
+			src: types.MsgResumeHooks{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ContractAddress: "invalid",
+			},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockWasmKeeper{
+				HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+					return true
+				},
+				SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					return nil, types.ErrInvalid
+				},
+			}
+			keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+			k := keepers.BabylonKeeper
+			ctx := keepers.Ctx
+
+			params := k.GetParams(ctx)
+			params.BtcStakingContractAddress = myContractAddr.String()
+			params.MaxConsecutiveHookFailures = 1
+			require.NoError(t, k.SetParams(ctx, params))
+			// suspend the hooks with a failing sudo call
+			require.NoError(t, k.BeginBlocker(ctx))
+			require.True(t, k.IsHookSuspended(ctx, myContractAddr))
+
+			// when
+			_, gotErr := keeper.NewMsgServer(k).ResumeHooks(ctx, &spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.True(t, k.IsHookSuspended(ctx, myContractAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.False(t, k.IsHookSuspended(ctx, myContractAddr))
+		})
+	}
+}
+
+func TestMsgSetAndRemoveContractAuthorization(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	myAuthorization := types.ContractAuthorization{
+		ContractAddress: myContractAddr.String(),
+		MsgTypes:        []string{types.CustomMsgTypeMintRewards},
+	}
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	ms := keeper.NewMsgServer(k)
+
+	// set with invalid authority
+	_, err := ms.SetContractAuthorization(ctx, &types.MsgSetContractAuthorization{Authority: myContractAddr.String(), Authorization: myAuthorization})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	// set with unknown msg type
+	_, err = ms.SetContractAuthorization(ctx, &types.MsgSetContractAuthorization{Authority: myAuthority, Authorization: types.ContractAuthorization{
+		ContractAddress: myContractAddr.String(),
+		MsgTypes:        []string{"unknown"},
+	}})
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+	// set
+	_, err = ms.SetContractAuthorization(ctx, &types.MsgSetContractAuthorization{Authority: myAuthority, Authorization: myAuthorization})
+	require.NoError(t, err)
+	gotAuth, found := k.GetContractAuthorization(ctx, myContractAddr)
+	require.True(t, found)
+	assert.Equal(t, myAuthorization, gotAuth)
+
+	// remove with invalid authority
+	_, err = ms.RemoveContractAuthorization(ctx, &types.MsgRemoveContractAuthorization{Authority: myContractAddr.String(), ContractAddress: myContractAddr.String()})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	// remove
+	_, err = ms.RemoveContractAuthorization(ctx, &types.MsgRemoveContractAuthorization{Authority: myAuthority, ContractAddress: myContractAddr.String()})
+	require.NoError(t, err)
+	_, found = k.GetContractAuthorization(ctx, myContractAddr)
+	assert.False(t, found)
+	// remove unknown
+	_, err = ms.RemoveContractAuthorization(ctx, &types.MsgRemoveContractAuthorization{Authority: myAuthority, ContractAddress: myContractAddr.String()})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestMsgUpdateStakingMsgPolicy(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	ms := keeper.NewMsgServer(k)
+	require.False(t, k.CanInvokeStakingMsg(ctx, myContractAddr))
+
+	specs := map[string]struct {
+		src    types.MsgUpdateStakingMsgPolicy
+		expErr error
+	}{
+		"invalid authority": {
+			src:    types.MsgUpdateStakingMsgPolicy{Authority: myContractAddr.String()},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"invalid address": {
+			src:    types.MsgUpdateStakingMsgPolicy{Authority: myAuthority, Policy: types.StakingMsgPolicy{AllowedContracts: []string{"invalid"}}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"duplicate address": {
+			src: types.MsgUpdateStakingMsgPolicy{Authority: myAuthority, Policy: types.StakingMsgPolicy{
+				AllowedContracts: []string{myContractAddr.String()},
+				DeniedContracts:  []string{myContractAddr.String()},
+			}},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"valid": {
+			src: types.MsgUpdateStakingMsgPolicy{Authority: myAuthority, Policy: types.StakingMsgPolicy{AllowedContracts: []string{myContractAddr.String()}}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := ms.UpdateStakingMsgPolicy(ctx, &spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.src.Policy, k.GetStakingMsgPolicy(ctx))
+			assert.True(t, k.CanInvokeStakingMsg(ctx, myContractAddr))
+		})
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	myBabylonAddr := sdk.AccAddress(rand.Bytes(32))
+	myBTCStakingAddr := sdk.AccAddress(rand.Bytes(32))
+	myAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	myChecksum := bytes.Repeat([]byte{1}, types.ChecksumLength)
+	otherChecksum := bytes.Repeat([]byte{2}, types.ChecksumLength)
+	mock := MockWasmKeeper{
+		GetContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+			if contractAddress.Equals(myBabylonAddr) || contractAddress.Equals(myBTCStakingAddr) {
+				return &wasmtypes.ContractInfo{CodeID: 1}
+			}
+			return nil
+		},
+		GetCodeInfoFn: func(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo {
+			return &wasmtypes.CodeInfo{CodeHash: myChecksum}
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx
+	ms := keeper.NewMsgServer(k)
+
+	validParams := func(mutators ...func(*types.Params)) types.Params {
+		p := types.DefaultParams(sdk.DefaultBondDenom)
+		p.BabylonContractAddress = myBabylonAddr.String()
+		p.BtcStakingContractAddress = myBTCStakingAddr.String()
+		for _, m := range mutators {
+			m(&p)
+		}
+		return p
+	}
+	specs := map[string]struct {
+		pins   types.CodePins
+		src    types.MsgUpdateParams
+		expErr error
+	}{
+		"valid": {
+			src: types.MsgUpdateParams{Authority: myAuthority, Params: validParams()},
+		},
+		"valid with pinned checksum": {
+			pins: types.CodePins{BabylonContractChecksums: [][]byte{otherChecksum, myChecksum}, BtcStakingContractChecksums: [][]byte{myChecksum}},
+			src:  types.MsgUpdateParams{Authority: myAuthority, Params: validParams()},
+		},
+		"valid without contracts": {
+			src: types.MsgUpdateParams{Authority: myAuthority, Params: types.DefaultParams(sdk.DefaultBondDenom)},
+		},
+		"invalid authority": {
+			src:    types.MsgUpdateParams{Authority: myBabylonAddr.String(), Params: validParams()},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		"malformed contract address": {
+			src: types.MsgUpdateParams{Authority: myAuthority, Params: validParams(func(p *types.Params) {
+				p.BtcStakingContractAddress = "invalid"
+			})},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"nonexistent contract": {
+			src: types.MsgUpdateParams{Authority: myAuthority, Params: validParams(func(p *types.Params) {
+				p.BabylonContractAddress = sdk.AccAddress(rand.Bytes(32)).String()
+			})},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"babylon contract code not pinned": {
+			pins:   types.CodePins{BabylonContractChecksums: [][]byte{otherChecksum}},
+			src:    types.MsgUpdateParams{Authority: myAuthority, Params: validParams()},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+		"btc staking contract code not pinned": {
+			pins:   types.CodePins{BtcStakingContractChecksums: [][]byte{otherChecksum}},
+			src:    types.MsgUpdateParams{Authority: myAuthority, Params: validParams()},
+			expErr: govtypes.ErrInvalidProposalMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(t, k.SetCodePins(cacheCtx, spec.pins))
+			_, gotErr := ms.UpdateParams(cacheCtx, &spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.src.Params, k.GetParams(cacheCtx))
+		})
+	}
+}
@@ -15,3 +15,19 @@ func WithWasmKeeperDecorated(cb func(types.WasmKeeper) types.WasmKeeper) Option
 		keeper.wasm = cb(keeper.wasm)
 	})
 }
+
+// WithSlashingKeeper sets the slashing keeper that is used to report tombstoned validators
+// in validator set updates
+func WithSlashingKeeper(slashing types.SlashingKeeper) Option {
+	return postOptsFn(func(keeper *Keeper) {
+		keeper.slashing = slashing
+	})
+}
+
+// WithContractOpsKeeper sets the wasm keeper that is used by the module to instantiate the Babylon contracts.
+// The module account is the creator, so the keeper should not restrict instantiation by the code access config.
+func WithContractOpsKeeper(contractOps types.ContractOpsKeeper) Option {
+	return postOptsFn(func(keeper *Keeper) {
+		keeper.contractOps = contractOps
+	})
+}
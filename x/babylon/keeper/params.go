@@ -1,35 +1,33 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // SetParams sets the module's parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.Marshal(&params)
-	if err != nil {
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := k.Params.Set(ctx, params); err != nil {
 		return err
 	}
-	store.Set(types.ParamsKey, bz)
-
+	k.invalidateHookTargets()
 	return nil
 }
 
 // GetParams gets the module's parameters.
-func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	store := clientCtx.KVStore(k.storeKey)
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return params
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
 	}
-
-	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-func (k Keeper) GetMaxSudoGas(ctx sdk.Context) storetypes.Gas {
-	return storetypes.Gas(k.GetParams(ctx).MaxGasBeginBlocker)
+// GetMaxSudoGas returns the gas limit for the contract sudo callback of the given block hook
+func (k Keeper) GetMaxSudoGas(ctx context.Context, hook string) storetypes.Gas {
+	return storetypes.Gas(k.GetParams(ctx).MaxSudoGas(hook))
 }
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
@@ -23,6 +28,262 @@ func NewQuerier(cdc codec.Codec, k *Keeper) *querier {
 
 // Params implements the gRPC service handler for querying the babylon parameters.
 func (q querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	params := q.k.GetParams(sdk.UnwrapSDKContext(ctx))
+	params := q.k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// HookStatus implements the gRPC service handler for querying the block hook status of a contract.
+func (q querier) HookStatus(ctx context.Context, req *types.QueryHookStatusRequest) (*types.QueryHookStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hookStatus := q.k.GetHookStatus(ctx, contractAddr)
+	return &types.QueryHookStatusResponse{Status: hookStatus}, nil
+}
+
+// ContractAuthorization implements the gRPC service handler for querying the custom message authorization of a contract.
+func (q querier) ContractAuthorization(ctx context.Context, req *types.QueryContractAuthorizationRequest) (*types.QueryContractAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auth, found := q.k.GetContractAuthorization(ctx, contractAddr)
+	if !found {
+		return nil, status.Error(codes.NotFound, "authorization not found")
+	}
+	return &types.QueryContractAuthorizationResponse{Authorization: auth}, nil
+}
+
+// ContractAuthorizations implements the gRPC service handler for querying all contract authorizations.
+func (q querier) ContractAuthorizations(ctx context.Context, req *types.QueryContractAuthorizationsRequest) (*types.QueryContractAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	auths, pageRes, err := query.CollectionPaginate(ctx, q.k.ContractAuthorizations, req.Pagination,
+		func(_ sdk.AccAddress, auth types.ContractAuthorization) (types.ContractAuthorization, error) {
+			return auth, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryContractAuthorizationsResponse{Authorizations: auths, Pagination: pageRes}, nil
+}
+
+// StakingMsgPolicy implements the gRPC service handler for querying the staking message policy.
+func (q querier) StakingMsgPolicy(ctx context.Context, req *types.QueryStakingMsgPolicyRequest) (*types.QueryStakingMsgPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	policy := q.k.GetStakingMsgPolicy(ctx)
+	return &types.QueryStakingMsgPolicyResponse{Policy: policy}, nil
+}
+
+// defaultContractPageLimit is the page size of contract queries without a pagination limit
+const defaultContractPageLimit = 10
+
+// contractPage converts the pagination of a request to the start_after key and limit of a
+// contract query. Offset based pagination is not supported by the contract.
+func contractPage(pageReq *query.PageRequest) (startAfter string, limit uint32, err error) {
+	if pageReq == nil {
+		return "", defaultContractPageLimit, nil
+	}
+	if pageReq.Offset != 0 || pageReq.CountTotal || pageReq.Reverse {
+		return "", 0, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+	}
+	limit = defaultContractPageLimit
+	if pageReq.Limit != 0 {
+		limit = uint32(min(pageReq.Limit, query.PaginationMaxLimit))
+	}
+	return string(pageReq.Key), limit, nil
+}
+
+// contractPageResponse returns the pagination response of a contract query result page
+func contractPageResponse(resultCount int, limit uint32, lastKey string) *query.PageResponse {
+	if resultCount < int(limit) {
+		return &query.PageResponse{}
+	}
+	return &query.PageResponse{NextKey: []byte(lastKey)}
+}
+
+// contractQueryError converts an error of a contract query to a gRPC status error
+func contractQueryError(err error) error {
+	switch {
+	case types.ErrNotFound.Is(err):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// FinalityProviders implements the gRPC service handler for querying the finality providers of the BTC staking contract.
+func (q querier) FinalityProviders(ctx context.Context, req *types.QueryFinalityProvidersRequest) (*types.QueryFinalityProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	startAfter, limit, err := contractPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := q.k.GetFinalityProviders(ctx, startAfter, limit)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	var lastKey string
+	if len(fps) != 0 {
+		lastKey = fps[len(fps)-1].BtcPkHex
+	}
+	return &types.QueryFinalityProvidersResponse{
+		FinalityProviders: fps,
+		Pagination:        contractPageResponse(len(fps), limit, lastKey),
+	}, nil
+}
+
+// Delegations implements the gRPC service handler for querying the BTC delegations of the BTC staking contract.
+func (q querier) Delegations(ctx context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	startAfter, limit, err := contractPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	dels, err := q.k.GetBTCDelegations(ctx, startAfter, limit)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	var lastKey string
+	if len(dels) != 0 {
+		lastKey = dels[len(dels)-1].StakingTxHashHex
+	}
+	return &types.QueryDelegationsResponse{
+		Delegations: dels,
+		Pagination:  contractPageResponse(len(dels), limit, lastKey),
+	}, nil
+}
+
+// ActivatedHeight implements the gRPC service handler for querying the BTC staking activation height.
+func (q querier) ActivatedHeight(ctx context.Context, req *types.QueryActivatedHeightRequest) (*types.QueryActivatedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	height, err := q.k.GetActivatedHeight(ctx)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	return &types.QueryActivatedHeightResponse{Height: height}, nil
+}
+
+// IndexedBlock implements the gRPC service handler for querying a block indexed by the BTC staking contract.
+func (q querier) IndexedBlock(ctx context.Context, req *types.QueryIndexedBlockRequest) (*types.QueryIndexedBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	block, err := q.k.GetIndexedBlock(ctx, req.Height)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	return &types.QueryIndexedBlockResponse{Block: block}, nil
+}
+
+// Block implements the gRPC service handler for querying the indexing and finalization status of a consumer block.
+func (q querier) Block(ctx context.Context, req *types.QueryBlockRequest) (*types.QueryBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be zero")
+	}
+	block, indexed, err := q.k.GetBlockStatus(ctx, req.Height)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	return &types.QueryBlockResponse{
+		Height:    req.Height,
+		Indexed:   indexed,
+		Finalized: block.Finalized,
+		AppHash:   block.AppHash,
+	}, nil
+}
+
+// FinalizedBlocks implements the gRPC service handler for querying the BTC-finalized consumer blocks.
+func (q querier) FinalizedBlocks(ctx context.Context, req *types.QueryFinalizedBlocksRequest) (*types.QueryFinalizedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var (
+		startAfter uint64
+		limit      uint32 = defaultContractPageLimit
+		reverse    bool
+	)
+	if pageReq := req.Pagination; pageReq != nil {
+		if pageReq.Offset != 0 || pageReq.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+		}
+		switch len(pageReq.Key) {
+		case 0:
+		case 8:
+			startAfter = sdk.BigEndianToUint64(pageReq.Key)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "pagination key must be a big endian encoded height")
+		}
+		if pageReq.Limit != 0 {
+			limit = uint32(min(pageReq.Limit, query.PaginationMaxLimit))
+		}
+		reverse = pageReq.Reverse
+	}
+	blocks, err := q.k.GetIndexedBlocks(ctx, startAfter, limit, true, reverse)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	latest, err := q.k.GetLatestFinalizedHeight(ctx)
+	if err != nil {
+		return nil, contractQueryError(err)
+	}
+	pageRsp := &query.PageResponse{}
+	if len(blocks) == int(limit) {
+		pageRsp.NextKey = sdk.Uint64ToBigEndian(blocks[len(blocks)-1].Height)
+	}
+	return &types.QueryFinalizedBlocksResponse{
+		Blocks:                blocks,
+		LatestFinalizedHeight: latest,
+		Pagination:            pageRsp,
+	}, nil
+}
+
+// CodePins implements the gRPC service handler for querying the code checksums that the Babylon contracts are pinned to.
+func (q querier) CodePins(ctx context.Context, req *types.QueryCodePinsRequest) (*types.QueryCodePinsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	return &types.QueryCodePinsResponse{Pins: q.k.GetCodePins(ctx)}, nil
+}
+
+// HookRegistrations implements the gRPC service handler for querying the block hook subscriptions of the hook registry.
+func (q querier) HookRegistrations(ctx context.Context, req *types.QueryHookRegistrationsRequest) (*types.QueryHookRegistrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[types.SchedulerPhase, sdk.AccAddress]])
+	if len(req.Hook) != 0 {
+		phase, err := types.SchedulerPhaseFromHook(req.Hook)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[types.SchedulerPhase, sdk.AccAddress](phase))
+	}
+	regs, pageRes, err := query.CollectionPaginate(ctx, q.k.HookRegistrations, req.Pagination,
+		func(_ collections.Pair[types.SchedulerPhase, sdk.AccAddress], reg types.HookRegistration) (types.HookRegistration, error) {
+			return reg, nil
+		}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryHookRegistrationsResponse{Registrations: regs, Pagination: pageRes}, nil
+}
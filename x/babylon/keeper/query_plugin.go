@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -10,12 +12,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
 
 type (
 	// abstract query keeper
 	ViewKeeper interface {
-		GetTest(ctx sdk.Context, actor sdk.AccAddress) string
+		GetParams(ctx context.Context) types.Params
+		GetIndexedHeader(ctx context.Context, height int64) (types.IndexedHeader, bool)
+		GetConsumerValidatorSet(ctx context.Context) ([]contract.Validator, error)
 	}
 )
 
@@ -52,14 +57,43 @@ func ChainedCustomQuerier(k ViewKeeper, next wasmkeeper.WasmVMQueryHandler) wasm
 		if err := json.Unmarshal(request.Custom, &contractQuery); err != nil {
 			return nil, errorsmod.Wrap(err, "babylon query")
 		}
-		query := contractQuery.Test
-		if query == nil {
+		var res any
+		switch {
+		case contractQuery.Params != nil:
+			params := k.GetParams(ctx)
+			res = contract.ParamsResponse{
+				MaxGasBeginBlocker:         params.MaxGasBeginBlocker,
+				MaxGasEndBlocker:           params.MaxGasEndBlocker,
+				HaltOnHookFailure:          params.HaltOnHookFailure,
+				MaxConsecutiveHookFailures: params.MaxConsecutiveHookFailures,
+			}
+		case contractQuery.ContractAddresses != nil:
+			params := k.GetParams(ctx)
+			res = contract.ContractAddressesResponse{
+				BabylonContract:    params.BabylonContractAddress,
+				BtcStakingContract: params.BtcStakingContractAddress,
+			}
+		case contractQuery.BlockHeader != nil:
+			height := contractQuery.BlockHeader.Height
+			header, found := k.GetIndexedHeader(ctx, int64(height))
+			if !found {
+				return nil, types.ErrNotFound.Wrapf("block header at height %d", height)
+			}
+			res = contract.BlockHeaderResponse{
+				Height:     height,
+				HashHex:    hex.EncodeToString(header.Hash),
+				AppHashHex: hex.EncodeToString(header.AppHash),
+				Time:       wasmvmtypes.Uint64(header.Time.UnixNano()),
+			}
+		case contractQuery.ValidatorSet != nil:
+			validators, err := k.GetConsumerValidatorSet(ctx)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "validator set")
+			}
+			res = contract.ValidatorSetResponse{Validators: validators}
+		default:
 			return next.HandleQuery(ctx, caller, request)
 		}
-
-		res := contract.TestResponse{
-			Placeholder2: "hello world",
-		}
 		return json.Marshal(res)
 	})
 }
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
 	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +20,11 @@ import (
 func TestChainedCustomQuerier(t *testing.T) {
 	myContractAddr := sdk.AccAddress(rand.Bytes(32))
 	keepers := NewTestKeepers(t)
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BabylonContractAddress = sdk.AccAddress(rand.Bytes(32)).String()
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, keepers.BabylonKeeper.SetParams(keepers.Ctx, params))
+	require.NoError(t, keepers.BabylonKeeper.IndexHeader(keepers.Ctx))
 
 	specs := map[string]struct {
 		src           wasmvmtypes.QueryRequest
@@ -37,6 +47,74 @@ func TestChainedCustomQuerier(t *testing.T) {
 			viewKeeper:    keepers.BabylonKeeper,
 			expNextCalled: true,
 		},
+		"params query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"params":{}}`),
+			},
+			viewKeeper: keepers.BabylonKeeper,
+			expData:    []byte(`{"max_gas_begin_blocker":500000,"max_gas_end_blocker":500000,"halt_on_hook_failure":false,"max_consecutive_hook_failures":0}`),
+		},
+		"contract addresses query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"contract_addresses":{}}`),
+			},
+			viewKeeper: keepers.BabylonKeeper,
+			expData:    []byte(`{"babylon_contract":"` + params.BabylonContractAddress + `","btc_staking_contract":"` + params.BtcStakingContractAddress + `"}`),
+		},
+		"block header query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"block_header":{"height":1234567}}`),
+			},
+			viewKeeper: keepers.BabylonKeeper,
+			expData:    []byte(`{"height":1234567,"hash_hex":"","app_hash_hex":"","time":"1587556800000000000"}`),
+		},
+		"block header query - unknown height": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"block_header":{"height":1}}`),
+			},
+			viewKeeper: keepers.BabylonKeeper,
+			expErr:     true,
+		},
+		"block header query - with hashes": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"block_header":{"height":7}}`),
+			},
+			viewKeeper: MockViewKeeper{
+				GetIndexedHeaderFn: func(ctx context.Context, height int64) (types.IndexedHeader, bool) {
+					return types.IndexedHeader{Height: height, Hash: []byte{0x1, 0x2}, AppHash: []byte{0xa}, Time: time.Unix(1, 0)}, true
+				},
+			},
+			expData: []byte(`{"height":7,"hash_hex":"0102","app_hash_hex":"0a","time":"1000000000"}`),
+		},
+		"validator set query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"validator_set":{}}`),
+			},
+			viewKeeper: MockViewKeeper{
+				GetConsumerValidatorSetFn: func(ctx context.Context) ([]contract.Validator, error) {
+					return []contract.Validator{{Address: "myValAddr", ConsAddress: "myConsAddr", Power: 10}}, nil
+				},
+			},
+			expData: []byte(`{"validators":[{"address":"myValAddr","cons_address":"myConsAddr","power":10}]}`),
+		},
+		"validator set query - empty set": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"validator_set":{}}`),
+			},
+			viewKeeper: keepers.BabylonKeeper,
+			expData:    []byte(`{"validators":[]}`),
+		},
+		"validator set query - error": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"validator_set":{}}`),
+			},
+			viewKeeper: MockViewKeeper{
+				GetConsumerValidatorSetFn: func(ctx context.Context) ([]contract.Validator, error) {
+					return nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -62,12 +140,28 @@ func TestChainedCustomQuerier(t *testing.T) {
 var _ keeper.ViewKeeper = &MockViewKeeper{}
 
 type MockViewKeeper struct {
-	GetTestFn func(ctx sdk.Context, actor sdk.AccAddress) string
+	GetParamsFn               func(ctx context.Context) types.Params
+	GetIndexedHeaderFn        func(ctx context.Context, height int64) (types.IndexedHeader, bool)
+	GetConsumerValidatorSetFn func(ctx context.Context) ([]contract.Validator, error)
+}
+
+func (m MockViewKeeper) GetParams(ctx context.Context) types.Params {
+	if m.GetParamsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetParamsFn(ctx)
+}
+
+func (m MockViewKeeper) GetIndexedHeader(ctx context.Context, height int64) (types.IndexedHeader, bool) {
+	if m.GetIndexedHeaderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetIndexedHeaderFn(ctx, height)
 }
 
-func (m MockViewKeeper) GetTest(ctx sdk.Context, actor sdk.AccAddress) string {
-	if m.GetTestFn == nil {
+func (m MockViewKeeper) GetConsumerValidatorSet(ctx context.Context) ([]contract.Validator, error) {
+	if m.GetConsumerValidatorSetFn == nil {
 		panic("not expected to be called")
 	}
-	return m.GetTestFn(ctx, actor)
+	return m.GetConsumerValidatorSetFn(ctx)
 }
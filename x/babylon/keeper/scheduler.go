@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// schedulerKey is the key of the scheduler queue: the block phase, the height and the contract address
+type schedulerKey = collections.Triple[types.SchedulerPhase, uint64, sdk.AccAddress]
+
+// ScheduleTask registers work of the contract for execution at the given block phase and height.
+// The height must be in the future. An existing task of the contract at the same phase and height
+// is replaced.
+func (k Keeper) ScheduleTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64, work types.ScheduledWork) error {
+	if phase.Hook() == "" {
+		return types.ErrInvalid.Wrapf("unknown scheduler phase: %d", phase)
+	}
+	if err := work.ValidateBasic(); err != nil {
+		return err
+	}
+	if height <= uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return types.ErrInvalid.Wrapf("height %d is not in the future", height)
+	}
+	if err := k.setScheduledTask(ctx, contractAddr, phase, height, work); err != nil {
+		return err
+	}
+	types.EmitSchedulerRegisteredEvent(ctx, contractAddr, height, work.Repeat)
+	return nil
+}
+
+func (k Keeper) setScheduledTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64, work types.ScheduledWork) error {
+	return k.ScheduledTasks.Set(ctx, collections.Join3(phase, height, contractAddr), work)
+}
+
+// GetScheduledTask returns the work that the contract scheduled at the given block phase and height
+func (k Keeper) GetScheduledTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64) (types.ScheduledWork, bool) {
+	work, err := k.ScheduledTasks.Get(ctx, collections.Join3(phase, height, contractAddr))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return work, false
+	case err != nil:
+		panic(err)
+	}
+	return work, true
+}
+
+// UnscheduleTask removes the work that the contract scheduled at the given block phase and height
+func (k Keeper) UnscheduleTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64) error {
+	key := collections.Join3(phase, height, contractAddr)
+	has, err := k.ScheduledTasks.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrNotFound.Wrapf("task of contract %s at %s height %d", contractAddr, phase.Hook(), height)
+	}
+	return k.ScheduledTasks.Remove(ctx, key)
+}
+
+// IterateScheduledTasks iterates over the work scheduled at the given block phase up to and including
+// maxHeight, ordered by height and contract address. Iteration stops when the callback returns true.
+func (k Keeper) IterateScheduledTasks(
+	ctx context.Context,
+	phase types.SchedulerPhase,
+	maxHeight uint64,
+	cb func(contractAddr sdk.AccAddress, height uint64, work types.ScheduledWork) bool,
+) error {
+	rng := new(collections.Range[schedulerKey]).Prefix(collections.TriplePrefix[types.SchedulerPhase, uint64, sdk.AccAddress](phase))
+	if maxHeight != ^uint64(0) {
+		rng = rng.EndExclusive(collections.TripleSuperPrefix[types.SchedulerPhase, uint64, sdk.AccAddress](phase, maxHeight+1))
+	}
+	return k.ScheduledTasks.Walk(ctx, rng, func(key schedulerKey, work types.ScheduledWork) (bool, error) {
+		return cb(key.K3(), key.K2(), work), nil
+	})
+}
+
+// ExecScheduledTasks sends a sudo message to every contract with work due at the given block phase.
+// At most MaxScheduledTasksPerBlock tasks are executed, the remaining due tasks are kept for the next blocks.
+// Each call is limited to the max sudo gas of the phase and its failure is isolated from the block.
+// Contracts with suspended hooks are not called, their repeated work keeps its schedule.
+// One-shot work is removed after execution, repeated work is rescheduled after a successful execution
+// and dropped after a failed one.
+func (k Keeper) ExecScheduledTasks(ctx context.Context, phase types.SchedulerPhase) error {
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	params := k.GetParams(ctx)
+	maxTasks := int(params.GetMaxScheduledTasksPerBlock())
+
+	type dueTask struct {
+		contractAddr sdk.AccAddress
+		height       uint64
+		work         types.ScheduledWork
+	}
+	var tasks []dueTask
+	err := k.IterateScheduledTasks(ctx, phase, currentHeight, func(contractAddr sdk.AccAddress, height uint64, work types.ScheduledWork) bool {
+		tasks = append(tasks, dueTask{contractAddr: contractAddr, height: height, work: work})
+		return len(tasks) >= maxTasks
+	})
+	if err != nil {
+		return err
+	}
+
+	gasLimit := k.GetMaxSudoGas(ctx, phase.Hook())
+	for _, t := range tasks {
+		if err := k.ScheduledTasks.Remove(ctx, collections.Join3(phase, t.height, t.contractAddr)); err != nil {
+			return err
+		}
+		if k.IsHookSuspended(ctx, t.contractAddr) {
+			k.Logger(ctx).Debug("skipping scheduled task of suspended contract", "phase", phase.Hook(), "contract", t.contractAddr.String(), "height", t.height)
+			if t.work.Repeat {
+				if err := k.rescheduleTask(ctx, t.contractAddr, phase, currentHeight, t.work); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		err := k.execScheduledTask(ctx, t.contractAddr, phase, t.height, t.work, gasLimit)
+		types.EmitSchedulerExecutionEvent(ctx, t.contractAddr, err)
+		if err != nil {
+			k.Logger(ctx).Error("scheduled task failed", "phase", phase.Hook(), "contract", t.contractAddr.String(), "height", t.height, "error", err)
+			if t.work.Repeat {
+				types.EmitSchedulerDroppedEvent(ctx, t.contractAddr, t.height, err)
+			}
+			continue
+		}
+		if t.work.Repeat {
+			if err := k.rescheduleTask(ctx, t.contractAddr, phase, currentHeight, t.work); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rescheduleTask registers repeated work again one interval after the current height
+func (k Keeper) rescheduleTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, currentHeight uint64, work types.ScheduledWork) error {
+	nextHeight := currentHeight + work.Interval
+	if err := k.setScheduledTask(ctx, contractAddr, phase, nextHeight, work); err != nil {
+		return err
+	}
+	types.EmitSchedulerRegisteredEvent(ctx, contractAddr, nextHeight, work.Repeat)
+	return nil
+}
+
+func (k Keeper) execScheduledTask(ctx context.Context, contractAddr sdk.AccAddress, phase types.SchedulerPhase, height uint64, work types.ScheduledWork, gasLimit storetypes.Gas) error {
+	if !k.wasm.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNotFound.Wrapf("contract %s", contractAddr)
+	}
+	msg := contract.SudoMsg{
+		ScheduledTaskMsg: &contract.ScheduledTask{
+			Phase:   phase.Hook(),
+			Height:  height,
+			Payload: work.Payload,
+		},
+	}
+	return k.doSudoCall(ctx, contractAddr, types.SudoHookScheduledTask, msg, gasLimit)
+}
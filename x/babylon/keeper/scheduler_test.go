@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestScheduleTask(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+
+	specs := map[string]struct {
+		phase  types.SchedulerPhase
+		height uint64
+		work   types.ScheduledWork
+		expErr bool
+	}{
+		"one-shot": {
+			phase:  types.SchedulerPhaseBeginBlock,
+			height: 101,
+		},
+		"repeated": {
+			phase:  types.SchedulerPhaseEndBlock,
+			height: 110,
+			work:   types.ScheduledWork{Repeat: true, Interval: 10},
+		},
+		"current height": {
+			phase:  types.SchedulerPhaseBeginBlock,
+			height: 100,
+			expErr: true,
+		},
+		"past height": {
+			phase:  types.SchedulerPhaseBeginBlock,
+			height: 1,
+			expErr: true,
+		},
+		"undefined phase": {
+			phase:  types.SchedulerPhaseUndefined,
+			height: 101,
+			expErr: true,
+		},
+		"repeated without interval": {
+			phase:  types.SchedulerPhaseBeginBlock,
+			height: 101,
+			work:   types.ScheduledWork{Repeat: true},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			gotErr := k.ScheduleTask(cacheCtx.WithEventManager(em), myContractAddr, spec.phase, spec.height, spec.work)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gotWork, found := k.GetScheduledTask(cacheCtx, myContractAddr, spec.phase, spec.height)
+			require.True(t, found)
+			assert.Equal(t, spec.work, gotWork)
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeSchedulerRegistered, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestExecScheduledTasks(t *testing.T) {
+	myOneShotContract := sdk.AccAddress(rand.Bytes(32))
+	myRepeatContract := sdk.AccAddress(rand.Bytes(32))
+	myFailingContract := sdk.AccAddress(rand.Bytes(32))
+	myLaterContract := sdk.AccAddress(rand.Bytes(32))
+
+	var calls []string
+	var gotMsgs []contract.ScheduledTask
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			var sudoMsg contract.SudoMsg
+			require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+			if sudoMsg.ScheduledTaskMsg == nil {
+				return nil, nil
+			}
+			calls = append(calls, contractAddress.String())
+			gotMsgs = append(gotMsgs, *sudoMsg.ScheduledTaskMsg)
+			if contractAddress.Equals(myFailingContract) {
+				return nil, types.ErrInvalid
+			}
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+
+	require.NoError(t, k.ScheduleTask(ctx, myOneShotContract, types.SchedulerPhaseBeginBlock, 101, types.ScheduledWork{Payload: []byte("my-payload")}))
+	require.NoError(t, k.ScheduleTask(ctx, myRepeatContract, types.SchedulerPhaseBeginBlock, 101, types.ScheduledWork{Repeat: true, Interval: 5}))
+	require.NoError(t, k.ScheduleTask(ctx, myFailingContract, types.SchedulerPhaseBeginBlock, 101, types.ScheduledWork{Repeat: true, Interval: 5}))
+	require.NoError(t, k.ScheduleTask(ctx, myLaterContract, types.SchedulerPhaseBeginBlock, 102, types.ScheduledWork{}))
+	require.NoError(t, k.ScheduleTask(ctx, myOneShotContract, types.SchedulerPhaseEndBlock, 101, types.ScheduledWork{}))
+
+	// when
+	ctx = ctx.WithBlockHeight(101)
+	em := sdk.NewEventManager()
+	require.NoError(t, k.BeginBlocker(ctx.WithEventManager(em)))
+
+	// then only the due begin block tasks are executed
+	assert.ElementsMatch(t, []string{myOneShotContract.String(), myRepeatContract.String(), myFailingContract.String()}, calls)
+	assert.Contains(t, gotMsgs, contract.ScheduledTask{Phase: types.SudoHookBeginBlock, Height: 101, Payload: []byte("my-payload")})
+	var execEvents int
+	var dropped []string
+	for _, e := range em.Events() {
+		switch e.Type {
+		case types.EventTypeSchedulerExec:
+			execEvents++
+		case types.EventTypeSchedulerDropped:
+			attr, ok := e.GetAttribute(types.AttributeKeyContractAddress)
+			require.True(t, ok)
+			dropped = append(dropped, attr.Value)
+		}
+	}
+	assert.Equal(t, 3, execEvents)
+	// and the failed repeated task is reported as dropped
+	assert.Equal(t, []string{myFailingContract.String()}, dropped)
+
+	// and executed one-shot and failed tasks are removed
+	_, found := k.GetScheduledTask(ctx, myOneShotContract, types.SchedulerPhaseBeginBlock, 101)
+	assert.False(t, found)
+	_, found = k.GetScheduledTask(ctx, myFailingContract, types.SchedulerPhaseBeginBlock, 101)
+	assert.False(t, found)
+	_, found = k.GetScheduledTask(ctx, myFailingContract, types.SchedulerPhaseBeginBlock, 106)
+	assert.False(t, found)
+	// and successful repeated tasks are rescheduled
+	_, found = k.GetScheduledTask(ctx, myRepeatContract, types.SchedulerPhaseBeginBlock, 106)
+	assert.True(t, found)
+	// and others are kept
+	_, found = k.GetScheduledTask(ctx, myLaterContract, types.SchedulerPhaseBeginBlock, 102)
+	assert.True(t, found)
+
+	// when end blocker runs
+	calls = nil
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{myOneShotContract.String()}, calls)
+	_, found = k.GetScheduledTask(ctx, myOneShotContract, types.SchedulerPhaseEndBlock, 101)
+	assert.False(t, found)
+}
+
+func TestExecScheduledTasksSkipsSuspendedContracts(t *testing.T) {
+	mySuspendedContract := sdk.AccAddress(rand.Bytes(32))
+	var calls int
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			calls++
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+	require.NoError(t, k.ScheduleTask(ctx, mySuspendedContract, types.SchedulerPhaseBeginBlock, 101, types.ScheduledWork{Repeat: true, Interval: 5}))
+	require.NoError(t, k.HookStatuses.Set(ctx, mySuspendedContract, types.HookStatus{Suspended: true}))
+
+	// when
+	ctx = ctx.WithBlockHeight(101)
+	require.NoError(t, k.ExecScheduledTasks(ctx, types.SchedulerPhaseBeginBlock))
+
+	// then the contract is not called
+	assert.Equal(t, 0, calls)
+	// and the repeated task keeps its schedule
+	_, found := k.GetScheduledTask(ctx, mySuspendedContract, types.SchedulerPhaseBeginBlock, 101)
+	assert.False(t, found)
+	_, found = k.GetScheduledTask(ctx, mySuspendedContract, types.SchedulerPhaseBeginBlock, 106)
+	assert.True(t, found)
+}
+
+func TestExecScheduledTasksPerBlockLimit(t *testing.T) {
+	var calls int
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			calls++
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.MaxScheduledTasksPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.ScheduleTask(ctx, sdk.AccAddress(rand.Bytes(32)), types.SchedulerPhaseBeginBlock, 101, types.ScheduledWork{}))
+	}
+
+	// when
+	ctx = ctx.WithBlockHeight(101)
+	require.NoError(t, k.ExecScheduledTasks(ctx, types.SchedulerPhaseBeginBlock))
+
+	// then only the limit is executed
+	assert.Equal(t, 2, calls)
+
+	// and the remaining due task in the next block
+	ctx = ctx.WithBlockHeight(102)
+	require.NoError(t, k.ExecScheduledTasks(ctx, types.SchedulerPhaseBeginBlock))
+	assert.Equal(t, 3, calls)
+	var pending int
+	require.NoError(t, k.IterateScheduledTasks(ctx, types.SchedulerPhaseBeginBlock, ^uint64(0), func(sdk.AccAddress, uint64, types.ScheduledWork) bool {
+		pending++
+		return false
+	}))
+	assert.Equal(t, 0, pending)
+}
+
+func TestUnscheduleTask(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(100)
+	require.NoError(t, k.ScheduleTask(ctx, myContractAddr, types.SchedulerPhaseEndBlock, 110, types.ScheduledWork{}))
+
+	require.ErrorIs(t, k.UnscheduleTask(ctx, myContractAddr, types.SchedulerPhaseBeginBlock, 110), types.ErrNotFound)
+	require.NoError(t, k.UnscheduleTask(ctx, myContractAddr, types.SchedulerPhaseEndBlock, 110))
+	_, found := k.GetScheduledTask(ctx, myContractAddr, types.SchedulerPhaseEndBlock, 110)
+	assert.False(t, found)
+}
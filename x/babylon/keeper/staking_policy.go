@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// SetStakingMsgPolicy stores the policy that controls which contracts may dispatch staking messages
+func (k Keeper) SetStakingMsgPolicy(ctx context.Context, policy types.StakingMsgPolicy) error {
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.StakingMsgPolicy.Set(ctx, policy)
+}
+
+// GetStakingMsgPolicy returns the staking message policy or the default policy when none was set
+func (k Keeper) GetStakingMsgPolicy(ctx context.Context) types.StakingMsgPolicy {
+	policy, err := k.StakingMsgPolicy.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.DefaultStakingMsgPolicy()
+	case err != nil:
+		panic(err)
+	}
+	return policy
+}
+
+// CanInvokeStakingMsg returns true when the staking message policy permits the contract to dispatch
+// staking messages. The deny list takes precedence over the allow list, which takes precedence over
+// the policy default.
+func (k Keeper) CanInvokeStakingMsg(ctx context.Context, actor sdk.AccAddress) bool {
+	policy := k.GetStakingMsgPolicy(ctx)
+	addrStr := actor.String()
+	switch {
+	case slices.Contains(policy.DeniedContracts, addrStr):
+		return false
+	case slices.Contains(policy.AllowedContracts, addrStr):
+		return true
+	default:
+		return policy.DefaultAllow
+	}
+}
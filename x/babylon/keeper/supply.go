@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// MintBlockRewards mints the given amount via the module account and sends it to the recipient.
+// Only the rewards denom of the params can be minted, up to the MaxBlockRewards param per block
+// in total for all contracts.
+func (k Keeper) MintBlockRewards(ctx context.Context, actor, recipient sdk.AccAddress, amt sdk.Coin) error {
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalid, "amount")
+	}
+	params := k.GetParams(ctx)
+	if amt.Denom != params.RewardsDenom {
+		return types.ErrInvalid.Wrapf("denom %s is not the rewards denom", amt.Denom)
+	}
+	if err := k.addMintedRewards(ctx, amt.Amount, params.MaxBlockRewards); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(amt)
+	if err := k.bank.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "mint rewards")
+	}
+	if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return errorsmod.Wrap(err, "send rewards")
+	}
+	types.EmitMintRewardsEvent(ctx, actor, recipient, amt)
+	return nil
+}
+
+// addMintedRewards adds the amount to the rewards minted in the current block and fails when the
+// total exceeds the max block rewards. The entries of previous blocks are pruned.
+func (k Keeper) addMintedRewards(ctx context.Context, amt sdkmath.Int, maxBlockRewards uint64) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	minted, err := k.MintedRewards.Get(ctx, height)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	total := sdkmath.NewIntFromUint64(minted).Add(amt)
+	if total.GT(sdkmath.NewIntFromUint64(maxBlockRewards)) {
+		return types.ErrMaxCapExceeded.Wrapf("block rewards of %s exceed the max of %d", total, maxBlockRewards)
+	}
+	if err := k.MintedRewards.Clear(ctx, new(collections.Range[uint64]).EndExclusive(height)); err != nil {
+		return err
+	}
+	return k.MintedRewards.Set(ctx, height, total.Uint64())
+}
+
+// BurnSlashed moves the given amount from the actor to the module account and burns it
+func (k Keeper) BurnSlashed(ctx context.Context, actor sdk.AccAddress, amt sdk.Coin) error {
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalid, "amount")
+	}
+	coins := sdk.NewCoins(amt)
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, actor, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "send slashed amount")
+	}
+	if err := k.bank.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "burn slashed amount")
+	}
+	types.EmitBurnSlashedEvent(ctx, actor, amt)
+	return nil
+}
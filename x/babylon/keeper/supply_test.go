@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestMintBlockRewardsCap(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myOtherContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myRecipient := sdk.AccAddress(rand.Bytes(32))
+	denom := sdk.DefaultBondDenom
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	ctx := keepers.Ctx.WithBlockHeight(10)
+	params := types.DefaultParams(denom)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// minting is disabled by default
+	err := k.MintBlockRewards(ctx, myContractAddr, myRecipient, sdk.NewInt64Coin(denom, 1))
+	require.ErrorIs(t, err, types.ErrMaxCapExceeded)
+
+	params.MaxBlockRewards = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the cap is shared by all contracts within a block
+	require.NoError(t, k.MintBlockRewards(ctx, myContractAddr, myRecipient, sdk.NewInt64Coin(denom, 60)))
+	err = k.MintBlockRewards(ctx, myOtherContractAddr, myRecipient, sdk.NewInt64Coin(denom, 41))
+	require.ErrorIs(t, err, types.ErrMaxCapExceeded)
+	require.NoError(t, k.MintBlockRewards(ctx, myOtherContractAddr, myRecipient, sdk.NewInt64Coin(denom, 40)))
+	assert.Equal(t, int64(100), keepers.BankKeeper.GetBalance(ctx, myRecipient, denom).Amount.Int64())
+
+	// and renewed in the next block
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.MintBlockRewards(ctx, myContractAddr, myRecipient, sdk.NewInt64Coin(denom, 100)))
+	has, err := k.MintedRewards.Has(ctx, 10)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// other denoms can not be minted
+	err = k.MintBlockRewards(ctx.WithBlockHeight(12), myContractAddr, myRecipient, sdk.NewInt64Coin("ibc/ABCD", 1))
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+)
+
+// GetConsumerValidatorSet returns the bonded validators of the consumer chain ordered by power
+func (k Keeper) GetConsumerValidatorSet(ctx context.Context) ([]contract.Validator, error) {
+	validators, err := k.Staking.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+	powerReduction := k.Staking.PowerReduction(ctx)
+	result := make([]contract.Validator, len(validators))
+	for i, v := range validators {
+		consAddr, err := v.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = contract.Validator{
+			Address:     v.GetOperator(),
+			ConsAddress: sdk.ConsAddress(consAddr).String(),
+			Power:       v.GetConsensusPower(powerReduction),
+		}
+	}
+	return result, nil
+}
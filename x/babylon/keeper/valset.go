@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// SendValsetUpdate sends the changes of the consumer validator set since the last update to the
+// BTC staking contract via sudo, when it is subscribed to them. Nothing is sent when the validator
+// set is unchanged. The first update after the contract is configured contains the full validator
+// set as additions. The new validator set is only stored when the contract processed the update.
+// Otherwise, the changes are sent again with the next update.
+func (k Keeper) SendValsetUpdate(ctx context.Context) error {
+	// the BTC staking contract is resolved once per block, see getHookTargets
+	target := k.getHookTargets(ctx).valsetUpdate
+	if target == nil {
+		return nil
+	}
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	update, err := k.updateConsumerValidators(cacheCtx)
+	if err != nil {
+		return err
+	}
+	if update.IsEmpty() {
+		// validator changes that are not reported to the contract are stored right away
+		writeCache()
+		return nil
+	}
+
+	// send the sudo call
+	msg := contract.SudoMsg{ValsetUpdateMsg: &update}
+	if k.callValsetUpdate(ctx, *target, msg) {
+		writeCache()
+	}
+	return nil
+}
+
+// callValsetUpdate sends the validator set update to the contract and returns true when the contract
+// processed it. As the changes are sent again with the next update, a failure never halts the chain.
+// Failures are counted apart from the block hooks, so that they do not trip the circuit breaker.
+func (k Keeper) callValsetUpdate(ctx context.Context, target hookTarget, msg contract.SudoMsg) bool {
+	contractAddr := target.contractAddr
+	if !k.isCodePinned(ctx, target, types.SudoHookValsetUpdate) {
+		return false
+	}
+	err := k.doSudoCall(ctx, contractAddr, types.SudoHookValsetUpdate, msg, target.gasLimit)
+	types.EmitHookExecutionEvent(ctx, contractAddr, types.SudoHookValsetUpdate, err)
+	k.recordValsetUpdateResult(ctx, contractAddr, err)
+	if err != nil {
+		k.Logger(ctx).Error("validator set update to contract failed", "contract", contractAddr.String(), "error", err)
+		return false
+	}
+	return true
+}
+
+// updateConsumerValidators replaces the stored validator set with the current bonded validators
+// and returns the difference
+func (k Keeper) updateConsumerValidators(ctx context.Context) (contract.ValsetUpdate, error) {
+	update := contract.ValsetUpdate{
+		Additions:    []contract.Validator{},
+		Removals:     []string{},
+		PowerChanged: []contract.Validator{},
+		Jailed:       []string{},
+		Tombstoned:   []string{},
+	}
+	previous := make(map[string]types.ConsumerValidator)
+	k.IterateConsumerValidators(ctx, func(v types.ConsumerValidator) bool {
+		previous[v.OperatorAddress] = v
+		return false
+	})
+
+	validators, err := k.Staking.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return update, err
+	}
+	powerReduction := k.Staking.PowerReduction(ctx)
+	current := make(map[string]struct{}, len(validators))
+	for _, v := range validators {
+		consAddr, err := v.GetConsAddr()
+		if err != nil {
+			return update, err
+		}
+		cv := types.ConsumerValidator{
+			OperatorAddress: v.GetOperator(),
+			ConsAddress:     sdk.ConsAddress(consAddr).String(),
+			Power:           v.GetConsensusPower(powerReduction),
+			Jailed:          v.IsJailed(),
+		}
+		current[cv.OperatorAddress] = struct{}{}
+		contractVal := contract.Validator{Address: cv.OperatorAddress, ConsAddress: cv.ConsAddress, Power: cv.Power}
+		prev, found := previous[cv.OperatorAddress]
+		switch {
+		case !found:
+			update.Additions = append(update.Additions, contractVal)
+		case prev.Power != cv.Power:
+			update.PowerChanged = append(update.PowerChanged, contractVal)
+		}
+		if cv.Jailed && !prev.Jailed {
+			k.appendPunishment(ctx, &update, cv.OperatorAddress, sdk.ConsAddress(consAddr))
+		}
+		if !found || !prev.Equal(cv) {
+			if err := k.setConsumerValidator(ctx, cv); err != nil {
+				return update, err
+			}
+		}
+	}
+
+	// previous validators are iterated in store order to keep the removals deterministic
+	var removed []types.ConsumerValidator
+	k.IterateConsumerValidators(ctx, func(v types.ConsumerValidator) bool {
+		if _, ok := current[v.OperatorAddress]; !ok {
+			removed = append(removed, v)
+		}
+		return false
+	})
+	for _, v := range removed {
+		update.Removals = append(update.Removals, v.OperatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(v.OperatorAddress)
+		if err != nil {
+			return update, err
+		}
+		if !v.Jailed {
+			// the validator may be gone from the staking module already
+			if val, err := k.Staking.GetValidator(ctx, valAddr); err == nil && val.IsJailed() {
+				consAddr, err := sdk.ConsAddressFromBech32(v.ConsAddress)
+				if err != nil {
+					return update, err
+				}
+				k.appendPunishment(ctx, &update, v.OperatorAddress, consAddr)
+			}
+		}
+		if err := k.ConsumerValidators.Remove(ctx, valAddr); err != nil {
+			return update, err
+		}
+	}
+	return update, nil
+}
+
+// appendPunishment adds a newly jailed validator to the tombstoned or jailed validators of the update
+func (k Keeper) appendPunishment(ctx context.Context, update *contract.ValsetUpdate, operatorAddr string, consAddr sdk.ConsAddress) {
+	if k.slashing != nil && k.slashing.IsTombstoned(ctx, consAddr) {
+		update.Tombstoned = append(update.Tombstoned, operatorAddr)
+		return
+	}
+	update.Jailed = append(update.Jailed, operatorAddr)
+}
+
+func (k Keeper) setConsumerValidator(ctx context.Context, v types.ConsumerValidator) error {
+	valAddr, err := sdk.ValAddressFromBech32(v.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	return k.ConsumerValidators.Set(ctx, valAddr, v)
+}
+
+// IterateConsumerValidators iterates over the validators of the last validator set update in the order
+// of the operator addresses. Iteration stops when the callback returns true.
+func (k Keeper) IterateConsumerValidators(ctx context.Context, cb func(v types.ConsumerValidator) bool) {
+	err := k.ConsumerValidators.Walk(ctx, nil, func(_ sdk.ValAddress, v types.ConsumerValidator) (bool, error) {
+		return cb(v), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
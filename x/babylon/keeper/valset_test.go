@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestSendValsetUpdate(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	val1, val2, val3, val4 := newTestValidator(t, 10), newTestValidator(t, 20), newTestValidator(t, 30), newTestValidator(t, 40)
+	allVals := map[string]*stakingtypes.Validator{
+		val1.OperatorAddress: &val1, val2.OperatorAddress: &val2, val3.OperatorAddress: &val3, val4.OperatorAddress: &val4,
+	}
+	bonded := []*stakingtypes.Validator{&val1, &val2, &val3}
+	tombstoned := map[string]bool{}
+
+	var gotUpdates []contract.ValsetUpdate
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			var sudoMsg contract.SudoMsg
+			require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+			if sudoMsg.ValsetUpdateMsg != nil {
+				gotUpdates = append(gotUpdates, *sudoMsg.ValsetUpdateMsg)
+			}
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t,
+		keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }),
+		keeper.WithSlashingKeeper(MockSlashingKeeper{IsTombstonedFn: func(ctx context.Context, consAddr sdk.ConsAddress) bool {
+			return tombstoned[consAddr.String()]
+		}}),
+	)
+	k := keepers.BabylonKeeper
+	k.Staking = MockStakingKeeper{
+		GetBondedValidatorsByPowerFn: func(ctx context.Context) ([]stakingtypes.Validator, error) {
+			result := make([]stakingtypes.Validator, len(bonded))
+			for i, v := range bonded {
+				result[i] = *v
+			}
+			return result, nil
+		},
+		GetValidatorFn: func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+			v, ok := allVals[addr.String()]
+			if !ok {
+				return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+			}
+			return *v, nil
+		},
+	}
+	ctx := keepers.Ctx
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.HookSubscriptions = []types.HookSubscription{{Contract: types.ContractBTCStaking, ValsetUpdate: true}}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// first update contains the full set
+	_, err := k.EndBlocker(ctx)
+	require.NoError(t, err)
+	require.Len(t, gotUpdates, 1)
+	assert.Equal(t, []contract.Validator{toContractValidator(t, val1, 10), toContractValidator(t, val2, 20), toContractValidator(t, val3, 30)}, gotUpdates[0].Additions)
+	assert.Empty(t, gotUpdates[0].Removals)
+
+	// no changes, no update
+	_, err = k.EndBlocker(ctx)
+	require.NoError(t, err)
+	require.Len(t, gotUpdates, 1)
+
+	// when val1 power changes, val2 is jailed, val3 is tombstoned and val4 joins
+	val1.Tokens = sdk.TokensFromConsensusPower(15, sdk.DefaultPowerReduction)
+	val2.Jailed = true
+	val3.Jailed = true
+	tombstoned[consAddrOf(t, val3).String()] = true
+	bonded = []*stakingtypes.Validator{&val1, &val4}
+	_, err = k.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, gotUpdates, 2)
+	assert.Equal(t, []contract.Validator{toContractValidator(t, val4, 40)}, gotUpdates[1].Additions)
+	assert.ElementsMatch(t, []string{val2.OperatorAddress, val3.OperatorAddress}, gotUpdates[1].Removals)
+	assert.Equal(t, []contract.Validator{toContractValidator(t, val1, 15)}, gotUpdates[1].PowerChanged)
+	assert.Equal(t, []string{val2.OperatorAddress}, gotUpdates[1].Jailed)
+	assert.Equal(t, []string{val3.OperatorAddress}, gotUpdates[1].Tombstoned)
+	var gotValset []types.ConsumerValidator
+	k.IterateConsumerValidators(ctx, func(v types.ConsumerValidator) bool {
+		gotValset = append(gotValset, v)
+		return false
+	})
+	require.Len(t, gotValset, 2)
+}
+
+func TestSendValsetUpdateNotDelivered(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	val1, val2 := newTestValidator(t, 10), newTestValidator(t, 20)
+	bonded := []stakingtypes.Validator{val1}
+
+	var gotUpdates []contract.ValsetUpdate
+	var sudoErr error
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			var sudoMsg contract.SudoMsg
+			require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+			if sudoMsg.ValsetUpdateMsg != nil {
+				gotUpdates = append(gotUpdates, *sudoMsg.ValsetUpdateMsg)
+			}
+			return nil, sudoErr
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	k.Staking = MockStakingKeeper{
+		GetBondedValidatorsByPowerFn: func(ctx context.Context) ([]stakingtypes.Validator, error) {
+			return bonded, nil
+		},
+		GetValidatorFn: func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+			return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+		},
+	}
+	ctx := keepers.Ctx
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	params.HookSubscriptions = []types.HookSubscription{{Contract: types.ContractBTCStaking, ValsetUpdate: true}}
+	params.HaltOnHookFailure = true
+	params.MaxConsecutiveHookFailures = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// when the contract fails to process the first update
+	sudoErr = types.ErrInvalid
+	require.NoError(t, k.SendValsetUpdate(ctx))
+	require.Len(t, gotUpdates, 1)
+
+	// then the failure is counted apart from the block hooks and neither halts nor suspends
+	assert.Equal(t, types.HookStatus{ConsecutiveValsetUpdateFailures: 1}, k.GetHookStatus(ctx, myContractAddr))
+
+	// and the validator set is not stored
+	var gotValset []types.ConsumerValidator
+	k.IterateConsumerValidators(ctx, func(v types.ConsumerValidator) bool {
+		gotValset = append(gotValset, v)
+		return false
+	})
+	assert.Empty(t, gotValset)
+
+	// and the changes are sent again with the next update
+	sudoErr = nil
+	bonded = []stakingtypes.Validator{val1, val2}
+	require.NoError(t, k.SendValsetUpdate(ctx))
+	require.Len(t, gotUpdates, 2)
+	assert.Equal(t, []contract.Validator{toContractValidator(t, val1, 10), toContractValidator(t, val2, 20)}, gotUpdates[1].Additions)
+	assert.Equal(t, types.HookStatus{}, k.GetHookStatus(ctx, myContractAddr))
+
+	// once delivered, only new changes are sent
+	bonded = []stakingtypes.Validator{val2}
+	require.NoError(t, k.SendValsetUpdate(ctx))
+	require.Len(t, gotUpdates, 3)
+	assert.Empty(t, gotUpdates[2].Additions)
+	assert.Equal(t, []string{val1.OperatorAddress}, gotUpdates[2].Removals)
+}
+
+func TestSendValsetUpdateRequiresSubscription(t *testing.T) {
+	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	var sudoCalls int
+	mock := MockWasmKeeper{
+		HasContractInfoFn: func(ctx context.Context, contractAddress sdk.AccAddress) bool {
+			return true
+		},
+		SudoFn: func(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			sudoCalls++
+			return nil, nil
+		},
+	}
+	keepers := NewTestKeepers(t, keeper.WithWasmKeeperDecorated(func(types.WasmKeeper) types.WasmKeeper { return mock }))
+	k := keepers.BabylonKeeper
+	k.Staking = MockStakingKeeper{
+		GetBondedValidatorsByPowerFn: func(ctx context.Context) ([]stakingtypes.Validator, error) {
+			return []stakingtypes.Validator{newTestValidator(t, 10)}, nil
+		},
+	}
+	ctx := keepers.Ctx
+	params := types.DefaultParams(sdk.DefaultBondDenom)
+	params.BtcStakingContractAddress = myContractAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// when
+	require.NoError(t, k.SendValsetUpdate(ctx))
+
+	// then the contract subscribed to the block hooks by default does not receive the update
+	assert.Equal(t, 0, sudoCalls)
+}
+
+func newTestValidator(t *testing.T, power int64) stakingtypes.Validator {
+	t.Helper()
+	v, err := stakingtypes.NewValidator(sdk.ValAddress(rand.Bytes(20)).String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	v.Status = stakingtypes.Bonded
+	v.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	return v
+}
+
+func consAddrOf(t *testing.T, v stakingtypes.Validator) sdk.ConsAddress {
+	t.Helper()
+	consAddr, err := v.GetConsAddr()
+	require.NoError(t, err)
+	return consAddr
+}
+
+func toContractValidator(t *testing.T, v stakingtypes.Validator, power int64) contract.Validator {
+	t.Helper()
+	return contract.Validator{Address: v.OperatorAddress, ConsAddress: consAddrOf(t, v).String(), Power: power}
+}
+
+var _ types.StakingKeeper = MockStakingKeeper{}
+
+type MockStakingKeeper struct {
+	GetBondedValidatorsByPowerFn func(ctx context.Context) ([]stakingtypes.Validator, error)
+	GetValidatorFn               func(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
+
+func (m MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error) {
+	if m.GetBondedValidatorsByPowerFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetBondedValidatorsByPowerFn(ctx)
+}
+
+func (m MockStakingKeeper) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	if m.GetValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetValidatorFn(ctx, addr)
+}
+
+func (m MockStakingKeeper) PowerReduction(ctx context.Context) math.Int {
+	return sdk.DefaultPowerReduction
+}
+
+var _ types.SlashingKeeper = MockSlashingKeeper{}
+
+type MockSlashingKeeper struct {
+	IsTombstonedFn func(ctx context.Context, consAddr sdk.ConsAddress) bool
+}
+
+func (m MockSlashingKeeper) IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool {
+	if m.IsTombstonedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsTombstonedFn(ctx, consAddr)
+}
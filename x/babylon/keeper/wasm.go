@@ -4,90 +4,238 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 
+	"cosmossdk.io/core/comet"
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	"github.com/babylonchain/babylon-sdk/x/babylon/contract"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (k Keeper) getBTCStakingContractAddr(ctx sdk.Context) sdk.AccAddress {
-	// get address of the BTC staking contract
-	addrStr := k.GetParams(ctx).BtcStakingContractAddress
+// getContractAddr returns the address of the configured contract with the given name or nil
+// when it is not set or not on-chain
+func (k Keeper) getContractAddr(ctx context.Context, params types.Params, contractName string) sdk.AccAddress {
+	addrStr := params.ContractAddress(contractName)
 	if len(addrStr) == 0 {
-		// the BTC staking contract address is not set yet, skip sending BeginBlockMsg
+		// the contract address is not set yet, skip sending messages
 		return nil
 	}
 	addr, err := sdk.AccAddressFromBech32(addrStr)
 	if err != nil {
-		// Although this is a programming error so we should panic, we emit
-		// a warning message to minimise the impact on the consumer chain's operation
-		k.Logger(ctx).Warn("the BTC staking contract address is malformed", "contract", addrStr, "error", err)
+		// Although this is a programming error so we should panic, the contract is
+		// treated as not set so that the hooks of the other contracts still run
+		k.Logger(ctx).Warn("the contract address is malformed", "name", contractName, "contract", addrStr, "error", err)
 		return nil
 	}
 	if !k.wasm.HasContractInfo(ctx, addr) {
 		// NOTE: it's possible that the default contract address does not correspond to
 		// any contract. We emit a warning message rather than panic to minimise the
 		// impact on the consumer chain's operation
-		k.Logger(ctx).Warn("the BTC staking contract address is not on-chain", "contract", addrStr)
+		k.Logger(ctx).Warn("the contract address is not on-chain", "name", contractName, "contract", addrStr)
 		return nil
 	}
 
 	return addr
 }
 
-// SendBeginBlockMsg sends a BeginBlock sudo message to the BTC staking contract via sudo
-func (k Keeper) SendBeginBlockMsg(c context.Context) error {
-	ctx := sdk.UnwrapSDKContext(c)
+// SendBeginBlockMsg sends a BeginBlock sudo message to the contracts subscribed to the BeginBlock hook
+func (k Keeper) SendBeginBlockMsg(ctx context.Context) error {
+	return k.sendBlockHook(ctx, types.SudoHookBeginBlock)
+}
 
-	// try to get and parse BTC staking contract
-	addr := k.getBTCStakingContractAddr(ctx)
-	if addr == nil {
-		return nil
-	}
+// SendEndBlockMsg sends a EndBlock sudo message to the contracts subscribed to the EndBlock hook
+func (k Keeper) SendEndBlockMsg(ctx context.Context) error {
+	return k.sendBlockHook(ctx, types.SudoHookEndBlock)
+}
 
-	// construct the sudo message
-	headerInfo := ctx.HeaderInfo()
-	msg := contract.SudoMsg{
-		BeginBlockMsg: &contract.BeginBlock{
-			HashHex:    hex.EncodeToString(headerInfo.Hash),
-			AppHashHex: hex.EncodeToString(headerInfo.AppHash),
-		},
+// sendBlockHook sends the sudo message of a block hook to the subscribed contracts. The contracts
+// configured in the params are called first in subscription order, followed by the contracts of
+// the hook registry in priority order. A contract is called at most once per hook.
+// Only the contracts that opted into the block information receive it, the others receive the
+// legacy payload with the block and app hashes.
+func (k Keeper) sendBlockHook(ctx context.Context, hook string) error {
+	legacyMsg := newBlockHookMsg(ctx, hook, nil)
+	blockInfo := newBlockInfo(ctx)
+	blockInfoMsg := newBlockHookMsg(ctx, hook, &blockInfo)
+	msgFor := func(target hookTarget) contract.SudoMsg {
+		if target.blockInfo {
+			return blockInfoMsg
+		}
+		return legacyMsg
 	}
 
-	// send the sudo call
-	return k.doSudoCall(ctx, addr, msg)
+	called := make(map[string]struct{})
+	for _, target := range k.getHookTargets(ctx).forHook(hook) {
+		addrStr := target.contractAddr.String()
+		if _, ok := called[addrStr]; ok {
+			continue
+		}
+		called[addrStr] = struct{}{}
+		if _, err := k.callHook(ctx, target, hook, msgFor(target)); err != nil {
+			return err
+		}
+	}
+	regs, err := k.GetHookRegistrations(ctx, hook)
+	if err != nil || len(regs) == 0 {
+		return err
+	}
+	params := k.GetParams(ctx)
+	pins := k.GetCodePins(ctx)
+	for _, reg := range regs {
+		if _, ok := called[reg.ContractAddress]; ok {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(reg.ContractAddress)
+		if err != nil {
+			// registrations are validated when stored, a malformed one is skipped so that
+			// the remaining registered contracts are still called
+			k.Logger(ctx).Warn("the registered contract address is malformed", "hook", hook, "contract", reg.ContractAddress, "error", err)
+			continue
+		}
+		if !k.wasm.HasContractInfo(ctx, addr) {
+			k.Logger(ctx).Warn("the registered contract is not on-chain", "hook", hook, "contract", reg.ContractAddress)
+			continue
+		}
+		called[reg.ContractAddress] = struct{}{}
+		target := hookTarget{
+			contractAddr:    addr,
+			gasLimit:        hookGasLimit(params, hook, reg.GasLimit),
+			pinnedChecksums: contractPins(params, pins, addr),
+			blockInfo:       reg.BlockInfo,
+		}
+		if _, err := k.callHook(ctx, target, hook, msgFor(target)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// SendEndBlockMsg sends a EndBlock sudo message to the BTC staking contract via sudo
-func (k Keeper) SendEndBlockMsg(c context.Context) error {
-	ctx := sdk.UnwrapSDKContext(c)
+// hookGasLimit returns the gas limit of a subscription or the max gas param of the hook when it is zero
+func hookGasLimit(params types.Params, hook string, gasLimit uint32) storetypes.Gas {
+	if gasLimit == 0 {
+		return params.MaxSudoGas(hook)
+	}
+	return storetypes.Gas(gasLimit)
+}
 
-	// try to get and parse BTC staking contract
-	addr := k.getBTCStakingContractAddr(ctx)
-	if addr == nil {
-		return nil
+// newBlockHookMsg builds the BeginBlock or EndBlock sudo message. The block information is
+// omitted from the payload when it is nil.
+func newBlockHookMsg(ctx context.Context, hook string, blockInfo *contract.BlockInfo) contract.SudoMsg {
+	headerInfo := sdk.UnwrapSDKContext(ctx).HeaderInfo()
+	hashHex, appHashHex := hex.EncodeToString(headerInfo.Hash), hex.EncodeToString(headerInfo.AppHash)
+	if hook == types.SudoHookBeginBlock {
+		return contract.SudoMsg{BeginBlockMsg: &contract.BeginBlock{HashHex: hashHex, AppHashHex: appHashHex, BlockInfo: blockInfo}}
 	}
+	return contract.SudoMsg{EndBlockMsg: &contract.EndBlock{HashHex: hashHex, AppHashHex: appHashHex, BlockInfo: blockInfo}}
+}
 
-	// construct the sudo message
-	headerInfo := ctx.HeaderInfo()
-	msg := contract.SudoMsg{
-		EndBlockMsg: &contract.EndBlock{
-			HashHex:    hex.EncodeToString(headerInfo.Hash),
-			AppHashHex: hex.EncodeToString(headerInfo.AppHash),
-		},
+// newBlockInfo builds the block information of the BeginBlock and EndBlock sudo messages
+// from the header info and the comet info of the context
+func newBlockInfo(ctx context.Context) contract.BlockInfo {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	headerInfo := sdkCtx.HeaderInfo()
+	info := contract.BlockInfo{
+		Version: contract.BlockInfoVersion,
+		Height:  uint64(headerInfo.Height),
+		Time:    wasmvmtypes.Uint64(headerInfo.Time.UnixNano()),
+		ChainID: headerInfo.ChainID,
+	}
+	cometInfo := sdkCtx.CometInfo()
+	if cometInfo == nil {
+		return info
+	}
+	if proposer := cometInfo.GetProposerAddress(); len(proposer) != 0 {
+		info.ProposerConsAddress = sdk.ConsAddress(proposer).String()
 	}
+	if lastCommit := cometInfo.GetLastCommit(); lastCommit != nil {
+		votes := lastCommit.Votes()
+		info.LastCommit = &contract.LastCommitInfo{
+			Round: lastCommit.Round(),
+			Votes: make([]contract.VoteInfo, votes.Len()),
+		}
+		for i := 0; i < votes.Len(); i++ {
+			vote := votes.Get(i)
+			info.LastCommit.Votes[i] = contract.VoteInfo{
+				ConsAddress: sdk.ConsAddress(vote.Validator().Address()).String(),
+				Power:       vote.Validator().Power(),
+				Signed:      vote.GetBlockIDFlag() == comet.BlockIDFlagCommit,
+			}
+		}
+	}
+	return info
+}
 
-	// send the sudo call
-	return k.doSudoCall(ctx, addr, msg)
+// callHook sends the sudo message of a block hook to the contract and isolates the
+// chain from its failure. Unless the HaltOnHookFailure param is set, a failed call is
+// only reported through logs and events so that the block can continue.
+// Contracts suspended by the circuit breaker or running code outside of their pins are not called.
+// The returned flag is true only when the contract processed the message successfully.
+func (k Keeper) callHook(ctx context.Context, target hookTarget, hook string, msg contract.SudoMsg) (bool, error) {
+	contractAddr := target.contractAddr
+	if k.IsHookSuspended(ctx, contractAddr) {
+		k.Logger(ctx).Debug("skipping suspended block hook", "hook", hook, "contract", contractAddr.String())
+		return false, nil
+	}
+	if !k.isCodePinned(ctx, target, hook) {
+		return false, nil
+	}
+	err := k.doSudoCall(ctx, contractAddr, hook, msg, target.gasLimit)
+	types.EmitHookExecutionEvent(ctx, contractAddr, hook, err)
+	k.recordHookResult(ctx, contractAddr, err)
+	if err == nil {
+		return true, nil
+	}
+	if k.GetParams(ctx).HaltOnHookFailure {
+		return false, err
+	}
+	k.Logger(ctx).Error("sudo call to contract failed", "hook", hook, "contract", contractAddr.String(), "error", err)
+	return false, nil
 }
 
-// caller must ensure gas limits are set proper and handle panics
-func (k Keeper) doSudoCall(ctx sdk.Context, contractAddr sdk.AccAddress, msg contract.SudoMsg) error {
+// doSudoCall executes the sudo call in a branched context with a gas meter limited
+// to gasLimit. The contract's writes are only committed when the call succeeds.
+// Running out of gas and any other panic are recovered and returned as error.
+func (k Keeper) doSudoCall(ctx context.Context, contractAddr sdk.AccAddress, hook string, msg contract.SudoMsg, gasLimit storetypes.Gas) (err error) {
 	bz, err := json.Marshal(msg)
 	if err != nil {
 		return errorsmod.Wrap(err, "marshal sudo msg")
 	}
-	resp, err := k.wasm.Sudo(ctx, contractAddr, bz)
-	k.Logger(ctx).Debug("response of sudo call %v to contract %s: %v", bz, contractAddr.String(), resp)
-	return err
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		gasUsed := gasMeter.GasConsumedToLimit()
+		telemetry.ModuleSetGauge(types.ModuleName, float32(gasUsed), "sudo", hook, "gas_used")
+		types.EmitSudoGasUsedEvent(ctx, contractAddr, hook, gasUsed, gasLimit)
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("sudo %s call to contract %s: %s", hook, contractAddr, oog.Descriptor)
+				return
+			}
+			err = types.ErrHookFailed.Wrapf("sudo %s call to contract %s panicked: %v", hook, contractAddr, r)
+		}
+	}()
+
+	resp, err := k.wasm.Sudo(cacheCtx, contractAddr, bz)
+	k.Logger(ctx).Debug("sudo call executed", "hook", hook, "contract", contractAddr.String(), "response", resp, "error", err)
+	if err != nil {
+		// the events of the branched context are dropped, the rejection is reported on the block context
+		var rejected *types.StakingMsgRejectedError
+		if errors.As(err, &rejected) {
+			types.EmitStakingMsgRejectedEvent(ctx, rejected.ContractAddress, rejected.MsgType)
+		}
+		return err
+	}
+
+	writeCache()
+	return nil
 }
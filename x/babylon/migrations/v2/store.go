@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The v1 store contains the params only, which are wire compatible with the v2 params. The migration:
+//
+//   - moves the params to the v2 schema. The single sudo gas limit of v1 becomes the limit of both the
+//     BeginBlock and EndBlock hooks and the header retention is set to the default. Minting block rewards
+//     stays disabled until governance sets the rewards denom and max block rewards.
+//   - initializes the singleton entries of the store prefixes added in v2 with their defaults.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	policy := types.DefaultStakingMsgPolicy()
+	if err := setIfAbsent(store, cdc, types.StakingMsgPolicyKey, &policy); err != nil {
+		return err
+	}
+	return setIfAbsent(store, cdc, types.CodePinsKey, &types.CodePins{})
+}
+
+// setIfAbsent stores the value under the given key unless the key exists already
+func setIfAbsent(store corestore.KVStore, cdc codec.BinaryCodec, key []byte, value proto.Message) error {
+	has, err := store.Has(key)
+	if err != nil || has {
+		return err
+	}
+	bz, err := cdc.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return store.Set(key, bz)
+}
+
+func migrateParams(store corestore.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+	if params.MaxGasBeginBlocker == 0 {
+		params.MaxGasBeginBlocker = types.DefaultParams(sdk.DefaultBondDenom).MaxGasBeginBlocker
+	}
+	if params.MaxGasEndBlocker == 0 {
+		params.MaxGasEndBlocker = params.MaxGasBeginBlocker
+	}
+	if params.HeaderRetentionBlocks == 0 {
+		params.HeaderRetentionBlocks = types.DefaultHeaderRetentionBlocks
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	if bz, err = cdc.Marshal(&params); err != nil {
+		return err
+	}
+	return store.Set(types.ParamsKey, bz)
+}
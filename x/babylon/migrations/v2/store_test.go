@@ -0,0 +1,142 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/babylonchain/babylon-sdk/x/babylon/migrations/v2"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+// v1Params encodes the params with the v1 schema:
+//
+//	message Params {
+//	  string babylon_contract_address = 1;
+//	  string btc_staking_contract_address = 2;
+//	  uint32 max_gas_begin_blocker = 3;
+//	}
+func v1Params(babylonContract, btcStakingContract string, maxGas uint32) []byte {
+	var bz []byte
+	if babylonContract != "" {
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, babylonContract)
+	}
+	if btcStakingContract != "" {
+		bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+		bz = protowire.AppendString(bz, btcStakingContract)
+	}
+	if maxGas != 0 {
+		bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, uint64(maxGas))
+	}
+	return bz
+}
+
+func TestMigrateStore(t *testing.T) {
+	myBabylonContract := sdk.AccAddress(make([]byte, 32)).String()
+	myBTCStakingContract := sdk.AccAddress(append(make([]byte, 31), 1)).String()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	specs := map[string]struct {
+		v1Store   map[string][]byte
+		expParams types.Params
+		expErr    bool
+	}{
+		"v1 params": {
+			v1Store: map[string][]byte{
+				string(types.ParamsKey): v1Params(myBabylonContract, myBTCStakingContract, 400_000),
+			},
+			expParams: types.Params{
+				BabylonContractAddress:    myBabylonContract,
+				BtcStakingContractAddress: myBTCStakingContract,
+				MaxGasBeginBlocker:        400_000,
+				MaxGasEndBlocker:          400_000,
+				HeaderRetentionBlocks:     types.DefaultHeaderRetentionBlocks,
+			},
+		},
+		"v1 params without gas limit": {
+			v1Store: map[string][]byte{
+				string(types.ParamsKey): v1Params("", myBTCStakingContract, 0),
+			},
+			expParams: types.Params{
+				BtcStakingContractAddress: myBTCStakingContract,
+				MaxGasBeginBlocker:        500_000,
+				MaxGasEndBlocker:          500_000,
+				HeaderRetentionBlocks:     types.DefaultHeaderRetentionBlocks,
+			},
+		},
+		"empty store": {
+			expParams: types.Params{MaxGasBeginBlocker: 500_000, MaxGasEndBlocker: 500_000, HeaderRetentionBlocks: types.DefaultHeaderRetentionBlocks},
+		},
+		"invalid contract address": {
+			v1Store: map[string][]byte{
+				string(types.ParamsKey): v1Params("invalid", "", 400_000),
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+			store := ctx.KVStore(storeKey)
+			for k, v := range spec.v1Store {
+				store.Set([]byte(k), v)
+			}
+
+			// when
+			gotErr := v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotParams types.Params
+			cdc.MustUnmarshal(store.Get(types.ParamsKey), &gotParams)
+			assert.Equal(t, spec.expParams, gotParams)
+
+			var gotPolicy types.StakingMsgPolicy
+			cdc.MustUnmarshal(store.Get(types.StakingMsgPolicyKey), &gotPolicy)
+			assert.Equal(t, types.DefaultStakingMsgPolicy(), gotPolicy)
+
+			var gotPins types.CodePins
+			cdc.MustUnmarshal(store.Get(types.CodePinsKey), &gotPins)
+			assert.Equal(t, types.CodePins{}, gotPins)
+		})
+	}
+}
+
+func TestMigrateStoreKeepsExistingEntries(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+	myPolicy := types.StakingMsgPolicy{DefaultAllow: true}
+	store.Set(types.StakingMsgPolicyKey, cdc.MustMarshal(&myPolicy))
+	myParams := types.Params{MaxGasBeginBlocker: 100_000, MaxGasEndBlocker: 200_000, HaltOnHookFailure: true, HeaderRetentionBlocks: 100}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&myParams))
+
+	// when
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	// then
+	var gotPolicy types.StakingMsgPolicy
+	cdc.MustUnmarshal(store.Get(types.StakingMsgPolicyKey), &gotPolicy)
+	assert.Equal(t, myPolicy, gotPolicy)
+	var gotParams types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &gotParams)
+	assert.Equal(t, myParams, gotParams)
+}
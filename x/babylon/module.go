@@ -6,6 +6,9 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -15,14 +18,17 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	modulev1 "github.com/babylonchain/babylon-sdk/x/api/babylonchain/babylon/module/v1"
 	"github.com/babylonchain/babylon-sdk/x/babylon/client/cli"
 	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
 )
 
 // ConsensusVersion defines the module's consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ appmodule.AppModule       = AppModule{}
@@ -108,6 +114,11 @@ func (AppModule) Name() string { return types.ModuleName }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.k))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.cdc, am.k))
+
+	m := keeper.NewMigrator(am.k)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -151,3 +162,100 @@ func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() { // marker
 }
+
+//
+// App Wiring Setup
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+// ModuleInputs are the dependencies of the babylon module in app wiring.
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+
+	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper
+	// optional, tombstoned validators are not reported without it
+	SlashingKeeper types.SlashingKeeper `optional:"true"`
+}
+
+// ModuleOutputs are the outputs of the babylon module in app wiring.
+type ModuleOutputs struct {
+	depinject.Out
+
+	BabylonKeeper *keeper.Keeper
+	Module        appmodule.AppModule
+	WasmOptions   WasmOptions
+	// WasmKeeper is the wasm keeper that the babylon keeper calls. The wasm module does not support app
+	// wiring and its keeper depends on the WasmOptions, so it can not be an input. Instead, the app
+	// instantiates the wasm keeper in place with the WasmOptions after the injection.
+	WasmKeeper *wasmkeeper.Keeper
+}
+
+// WasmOptions are the wasm keeper options that route the custom messages and queries of the contracts
+// to the babylon module. They must be passed to the wasm keeper constructor.
+type WasmOptions struct {
+	// MessageHandler is the message handler chain with the integrity handler first and the custom message handler last
+	MessageHandler wasmkeeper.Option
+	// QueryHandler is the query decorator for the custom queries
+	QueryHandler wasmkeeper.Option
+	// WasmEngine is the wasm engine decorator that drops the cached block hook targets on contract migrations
+	WasmEngine wasmkeeper.Option
+}
+
+// All returns all wasm keeper options
+func (o WasmOptions) All() []wasmkeeper.Option {
+	return []wasmkeeper.Option{o.MessageHandler, o.QueryHandler, o.WasmEngine}
+}
+
+// NewWasmOptions returns the wasm keeper options of the module for the given keeper
+func NewWasmOptions(k *keeper.Keeper) WasmOptions {
+	return WasmOptions{
+		MessageHandler: wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+			return wasmkeeper.NewMessageHandlerChain(
+				// security layer for system integrity, should always be first in chain
+				keeper.NewIntegrityHandler(k),
+				nested,
+				keeper.NewDefaultCustomMsgHandler(k),
+			)
+		}),
+		QueryHandler: wasmkeeper.WithQueryHandlerDecorator(keeper.NewQueryDecorator(k)),
+		WasmEngine:   wasmkeeper.WithWasmEngineDecorator(keeper.NewWasmEngineDecorator(k)),
+	}
+}
+
+// ProvideModule provides the babylon keeper, module, wasm keeper options and wasm keeper for app wiring.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	// the wasm keeper is instantiated by the app after the injection, see ModuleOutputs
+	wasmKeeper := new(wasmkeeper.Keeper)
+	opts := []keeper.Option{keeper.WithContractOpsKeeper(wasmkeeper.NewGovPermissionKeeper(wasmKeeper))}
+	if in.SlashingKeeper != nil {
+		opts = append(opts, keeper.WithSlashingKeeper(in.SlashingKeeper))
+	}
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreService,
+		in.BankKeeper,
+		in.StakingKeeper,
+		wasmKeeper,
+		authority.String(),
+		opts...,
+	)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{BabylonKeeper: k, Module: m, WasmOptions: NewWasmOptions(k), WasmKeeper: wasmKeeper}
+}
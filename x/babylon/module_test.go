@@ -0,0 +1,143 @@
+package babylon_test
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/iancoleman/strcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	modulev1 "github.com/babylonchain/babylon-sdk/x/api/babylonchain/babylon/module/v1"
+	"github.com/babylonchain/babylon-sdk/x/babylon"
+	"github.com/babylonchain/babylon-sdk/x/babylon/client/cli"
+	"github.com/babylonchain/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+)
+
+func TestAutoCLIOptionsCoverAllServices(t *testing.T) {
+	opts := babylon.AppModule{}.AutoCLIOptions()
+	for _, svc := range []struct {
+		name    string
+		options []string
+	}{
+		{name: opts.Query.Service, options: rpcMethods(opts.Query.RpcCommandOptions)},
+		{name: opts.Tx.Service, options: rpcMethods(opts.Tx.RpcCommandOptions)},
+	} {
+		desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(svc.name))
+		require.NoError(t, err, svc.name)
+		methods := desc.(protoreflect.ServiceDescriptor).Methods()
+		var exp []string
+		for i := 0; i < methods.Len(); i++ {
+			exp = append(exp, string(methods.Get(i).Name()))
+		}
+		assert.ElementsMatch(t, exp, svc.options, svc.name)
+	}
+}
+
+func TestAutoCLISkipsOnlyAuthorityMsgs(t *testing.T) {
+	opts := babylon.AppModule{}.AutoCLIOptions()
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(opts.Tx.Service))
+	require.NoError(t, err)
+	methods := desc.(protoreflect.ServiceDescriptor).Methods()
+	for _, o := range opts.Tx.RpcCommandOptions {
+		method := methods.ByName(protoreflect.Name(o.RpcMethod))
+		require.NotNil(t, method, o.RpcMethod)
+		if !o.Skip {
+			continue
+		}
+		// a skipped Msg must be signed by the authority and submitted through governance
+		signers := protov2.GetExtension(method.Input().Options(), msgv1.E_Signer).([]string)
+		assert.Equal(t, []string{"authority"}, signers, o.RpcMethod)
+		assert.NotNil(t, method.Input().Fields().ByName("authority"), o.RpcMethod)
+		// with a handwritten proposal command
+		proposalCmd, _, err := cli.GetTxCmd().Find([]string{strcase.ToKebab(o.RpcMethod) + "-proposal"})
+		require.NoError(t, err, o.RpcMethod)
+		assert.NotNil(t, proposalCmd.Flags().Lookup(govcli.FlagTitle), o.RpcMethod)
+	}
+}
+
+func rpcMethods[T interface{ GetRpcMethod() string }](opts []T) []string {
+	r := make([]string, len(opts))
+	for i, o := range opts {
+		r[i] = o.GetRpcMethod()
+	}
+	return r
+}
+
+func TestProvideModule(t *testing.T) {
+	myAuthority := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		authority    string
+		expAuthority string
+	}{
+		"default authority": {
+			expAuthority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		},
+		"custom authority": {
+			authority:    myAuthority,
+			expAuthority: myAuthority,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			out := babylon.ProvideModule(babylon.ModuleInputs{
+				Config:       &modulev1.Module{Authority: spec.authority},
+				Cdc:          codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+				StoreService: runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)),
+			})
+			require.NotNil(t, out.BabylonKeeper)
+			assert.Equal(t, spec.expAuthority, out.BabylonKeeper.GetAuthority())
+			assert.NotNil(t, out.Module)
+			assert.Len(t, out.WasmOptions.All(), 3)
+			assert.NotNil(t, out.WasmKeeper)
+		})
+	}
+}
+
+func TestProvideModuleInjection(t *testing.T) {
+	var (
+		babylonKeeper *keeper.Keeper
+		wasmOpts      babylon.WasmOptions
+		wasmKeeper    *wasmkeeper.Keeper
+	)
+
+	// when
+	err := depinject.Inject(
+		depinject.Configs(
+			depinject.Supply(
+				&modulev1.Module{},
+				codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+				runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)),
+				bankkeeper.BaseKeeper{},
+				&stakingkeeper.Keeper{},
+			),
+			depinject.ProvideInModule(types.ModuleName, babylon.ProvideModule),
+		),
+		&babylonKeeper, &wasmOpts, &wasmKeeper,
+	)
+
+	// then all inputs are resolved by the container
+	require.NoError(t, err)
+	require.NotNil(t, babylonKeeper)
+	assert.Len(t, wasmOpts.All(), 3)
+	// and the wasm keeper is left for the app to instantiate
+	assert.Equal(t, wasmkeeper.Keeper{}, *wasmKeeper)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CustomMsgTypeMintRewards is the type of the mint rewards custom message
+	CustomMsgTypeMintRewards = "mint_rewards"
+	// CustomMsgTypeBurnSlashed is the type of the burn slashed custom message
+	CustomMsgTypeBurnSlashed = "burn_slashed"
+	// CustomMsgTypeScheduleTask is the type of the schedule task and unschedule task custom messages
+	CustomMsgTypeScheduleTask = "schedule_task"
+)
+
+// CustomMsgTypes are all custom message types that contracts can dispatch to the module
+var CustomMsgTypes = []string{CustomMsgTypeMintRewards, CustomMsgTypeBurnSlashed, CustomMsgTypeScheduleTask}
+
+// ValidateBasic performs basic validation on a contract authorization.
+func (a ContractAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.ContractAddress); err != nil {
+		return ErrInvalid.Wrapf("contract address: %s", err)
+	}
+	if len(a.MsgTypes) == 0 {
+		return ErrInvalid.Wrap("empty msg types")
+	}
+	seen := make(map[string]struct{}, len(a.MsgTypes))
+	for _, msgType := range a.MsgTypes {
+		if !slices.Contains(CustomMsgTypes, msgType) {
+			return ErrInvalid.Wrapf("unknown msg type: %s", msgType)
+		}
+		if _, ok := seen[msgType]; ok {
+			return ErrInvalid.Wrapf("duplicate msg type: %s", msgType)
+		}
+		seen[msgType] = struct{}{}
+	}
+	return nil
+}
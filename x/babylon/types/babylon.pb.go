@@ -4,19 +4,24 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,8 +36,40 @@ type Params struct {
 	// btc_staking_contract_address is the address of the BTC staking contract
 	BtcStakingContractAddress string `protobuf:"bytes,2,opt,name=btc_staking_contract_address,json=btcStakingContractAddress,proto3" json:"btc_staking_contract_address,omitempty"`
 	// max_gas_begin_blocker defines the maximum gas that can be spent in a
-	// contract sudo callback
+	// contract sudo callback at BeginBlock
 	MaxGasBeginBlocker uint32 `protobuf:"varint,3,opt,name=max_gas_begin_blocker,json=maxGasBeginBlocker,proto3" json:"max_gas_begin_blocker,omitempty"`
+	// halt_on_hook_failure defines whether a failing contract sudo callback in
+	// BeginBlock or EndBlock halts the chain. When disabled, the failure is
+	// logged, its state changes are discarded and the block continues.
+	HaltOnHookFailure bool `protobuf:"varint,4,opt,name=halt_on_hook_failure,json=haltOnHookFailure,proto3" json:"halt_on_hook_failure,omitempty"`
+	// max_consecutive_hook_failures defines the number of consecutive failed
+	// sudo callbacks after which the block hooks of a contract are suspended.
+	// Zero disables the circuit breaker.
+	MaxConsecutiveHookFailures uint32 `protobuf:"varint,5,opt,name=max_consecutive_hook_failures,json=maxConsecutiveHookFailures,proto3" json:"max_consecutive_hook_failures,omitempty"`
+	// max_gas_end_blocker defines the maximum gas that can be spent in a
+	// contract sudo callback at EndBlock
+	MaxGasEndBlocker uint32 `protobuf:"varint,6,opt,name=max_gas_end_blocker,json=maxGasEndBlocker,proto3" json:"max_gas_end_blocker,omitempty"`
+	// hook_subscriptions define which of the Babylon and BTC staking contracts
+	// receive the BeginBlock and EndBlock sudo callbacks, in list order, and
+	// whether the BTC staking contract receives the validator set updates. When
+	// empty, the BTC staking contract receives both block callbacks and no
+	// validator set updates.
+	HookSubscriptions []HookSubscription `protobuf:"bytes,8,rep,name=hook_subscriptions,json=hookSubscriptions,proto3" json:"hook_subscriptions"`
+	// header_retention_blocks is the number of recent block headers that are
+	// kept indexed. At height H, the headers up to height
+	// H - header_retention_blocks are pruned. Zero means the default of 10000
+	// blocks.
+	HeaderRetentionBlocks uint32 `protobuf:"varint,9,opt,name=header_retention_blocks,json=headerRetentionBlocks,proto3" json:"header_retention_blocks,omitempty"`
+	// rewards_denom is the only denom that contracts can mint as block rewards
+	RewardsDenom string `protobuf:"bytes,10,opt,name=rewards_denom,json=rewardsDenom,proto3" json:"rewards_denom,omitempty"`
+	// max_block_rewards is the maximum amount of the rewards denom that
+	// contracts can mint in total in a block. Zero disables minting.
+	MaxBlockRewards uint64 `protobuf:"varint,11,opt,name=max_block_rewards,json=maxBlockRewards,proto3" json:"max_block_rewards,omitempty"`
+	// max_scheduled_tasks_per_block is the maximum number of scheduled tasks
+	// that are executed at each of BeginBlock and EndBlock. Due tasks over the
+	// limit are executed in the following blocks. Zero means the default of 100
+	// tasks.
+	MaxScheduledTasksPerBlock uint32 `protobuf:"varint,12,opt,name=max_scheduled_tasks_per_block,json=maxScheduledTasksPerBlock,proto3" json:"max_scheduled_tasks_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +105,396 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// HookSubscription opts a contract configured in the params into block hooks
+type HookSubscription struct {
+	// contract is the configured contract, "babylon" or "btc_staking"
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// begin_block subscribes the contract to the BeginBlock sudo callback
+	BeginBlock bool `protobuf:"varint,2,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// end_block subscribes the contract to the EndBlock sudo callback
+	EndBlock bool `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// gas_limit is the maximum gas of a callback to the contract. Zero uses the
+	// max gas param of the hook.
+	GasLimit uint32 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// block_info opts the contract into the versioned block information of the
+	// BeginBlock and EndBlock sudo messages. Otherwise, the messages only contain
+	// the block and app hashes.
+	BlockInfo bool `protobuf:"varint,5,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
+	// valset_update subscribes the contract to the validator set update sudo
+	// callback. Only the BTC staking contract can subscribe to it.
+	ValsetUpdate bool `protobuf:"varint,6,opt,name=valset_update,json=valsetUpdate,proto3" json:"valset_update,omitempty"`
+}
+
+func (m *HookSubscription) Reset()         { *m = HookSubscription{} }
+func (m *HookSubscription) String() string { return proto.CompactTextString(m) }
+func (*HookSubscription) ProtoMessage()    {}
+func (*HookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{1}
+}
+func (m *HookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookSubscription.Merge(m, src)
+}
+func (m *HookSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookSubscription proto.InternalMessageInfo
+
+// HookStatus tracks the health of the block hooks of a contract
+type HookStatus struct {
+	// consecutive_failures is the number of sudo callbacks to the contract that
+	// failed in a row
+	ConsecutiveFailures uint64 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// suspended is set when the circuit breaker stopped calling the contract
+	Suspended bool `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// suspended_at_height is the block height at which the hooks were suspended
+	SuspendedAtHeight int64 `protobuf:"varint,3,opt,name=suspended_at_height,json=suspendedAtHeight,proto3" json:"suspended_at_height,omitempty"`
+	// consecutive_valset_update_failures is the number of validator set updates
+	// to the contract that failed in a row. They are counted separately from the
+	// block hooks and never suspend the contract.
+	ConsecutiveValsetUpdateFailures uint64 `protobuf:"varint,4,opt,name=consecutive_valset_update_failures,json=consecutiveValsetUpdateFailures,proto3" json:"consecutive_valset_update_failures,omitempty"`
+}
+
+func (m *HookStatus) Reset()         { *m = HookStatus{} }
+func (m *HookStatus) String() string { return proto.CompactTextString(m) }
+func (*HookStatus) ProtoMessage()    {}
+func (*HookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{2}
+}
+func (m *HookStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookStatus.Merge(m, src)
+}
+func (m *HookStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookStatus proto.InternalMessageInfo
+
+// IndexedHeader is the header information of a consumer chain block that is
+// kept by the module so that contracts can look it up by height
+type IndexedHeader struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash is the hash of the block
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// app_hash is the app hash of the block
+	AppHash []byte `protobuf:"bytes,3,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// time is the timestamp of the block
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *IndexedHeader) Reset()         { *m = IndexedHeader{} }
+func (m *IndexedHeader) String() string { return proto.CompactTextString(m) }
+func (*IndexedHeader) ProtoMessage()    {}
+func (*IndexedHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{3}
+}
+func (m *IndexedHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedHeader.Merge(m, src)
+}
+func (m *IndexedHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedHeader proto.InternalMessageInfo
+
+// ContractAuthorization lists the Babylon custom messages that a contract is
+// permitted to dispatch
+type ContractAuthorization struct {
+	// contract_address is the address of the authorized contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// msg_types are the custom message types that the contract may dispatch,
+	// e.g. "mint_rewards"
+	MsgTypes []string `protobuf:"bytes,2,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+}
+
+func (m *ContractAuthorization) Reset()         { *m = ContractAuthorization{} }
+func (m *ContractAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractAuthorization) ProtoMessage()    {}
+func (*ContractAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{4}
+}
+func (m *ContractAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAuthorization.Merge(m, src)
+}
+func (m *ContractAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAuthorization proto.InternalMessageInfo
+
+// StakingMsgPolicy controls which contracts may dispatch staking messages,
+// either directly or wrapped in an Any/Stargate message
+type StakingMsgPolicy struct {
+	// default_allow defines whether contracts that are in neither list may
+	// dispatch staking messages
+	DefaultAllow bool `protobuf:"varint,1,opt,name=default_allow,json=defaultAllow,proto3" json:"default_allow,omitempty"`
+	// allowed_contracts are the contracts that may dispatch staking messages
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// denied_contracts are the contracts that must not dispatch staking
+	// messages. The deny list takes precedence over the allow list.
+	DeniedContracts []string `protobuf:"bytes,3,rep,name=denied_contracts,json=deniedContracts,proto3" json:"denied_contracts,omitempty"`
+}
+
+func (m *StakingMsgPolicy) Reset()         { *m = StakingMsgPolicy{} }
+func (m *StakingMsgPolicy) String() string { return proto.CompactTextString(m) }
+func (*StakingMsgPolicy) ProtoMessage()    {}
+func (*StakingMsgPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{5}
+}
+func (m *StakingMsgPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingMsgPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingMsgPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingMsgPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingMsgPolicy.Merge(m, src)
+}
+func (m *StakingMsgPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingMsgPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingMsgPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingMsgPolicy proto.InternalMessageInfo
+
+// ConsumerValidator is a validator of the consumer chain as of the last
+// validator set update that was sent to the BTC staking contract
+type ConsumerValidator struct {
+	// operator_address is the bech32 validator operator address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// cons_address is the bech32 consensus address of the validator
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// power is the consensus power of the validator
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// jailed is set when the validator is jailed
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *ConsumerValidator) Reset()         { *m = ConsumerValidator{} }
+func (m *ConsumerValidator) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValidator) ProtoMessage()    {}
+func (*ConsumerValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{6}
+}
+func (m *ConsumerValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValidator.Merge(m, src)
+}
+func (m *ConsumerValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerValidator proto.InternalMessageInfo
+
+// CodePins are the wasm code checksums that the Babylon and BTC staking
+// contracts are pinned to. The module rejects params updates and contract
+// instantiations with contracts that run code outside of their pins and does
+// not call the block hooks of such contracts. An empty list does not pin the
+// code of the contract.
+type CodePins struct {
+	// babylon_contract_checksums are the allowed code checksums of the Babylon
+	// contract
+	BabylonContractChecksums [][]byte `protobuf:"bytes,1,rep,name=babylon_contract_checksums,json=babylonContractChecksums,proto3" json:"babylon_contract_checksums,omitempty"`
+	// btc_staking_contract_checksums are the allowed code checksums of the BTC
+	// staking contract
+	BtcStakingContractChecksums [][]byte `protobuf:"bytes,2,rep,name=btc_staking_contract_checksums,json=btcStakingContractChecksums,proto3" json:"btc_staking_contract_checksums,omitempty"`
+}
+
+func (m *CodePins) Reset()         { *m = CodePins{} }
+func (m *CodePins) String() string { return proto.CompactTextString(m) }
+func (*CodePins) ProtoMessage()    {}
+func (*CodePins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{7}
+}
+func (m *CodePins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodePins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodePins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodePins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodePins.Merge(m, src)
+}
+func (m *CodePins) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodePins) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodePins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodePins proto.InternalMessageInfo
+
+// HookRegistration subscribes a contract to a block hook in the hook registry
+type HookRegistration struct {
+	// contract_address is the address of the subscribed contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// hook is the block hook, "begin_block" or "end_block"
+	Hook string `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
+	// gas_limit is the maximum gas of a callback to the contract. Zero uses the
+	// max gas param of the hook.
+	GasLimit uint32 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// priority orders the callbacks of a hook. Contracts with a higher priority
+	// are called first, contracts with the same priority in address order.
+	Priority uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// block_info opts the contract into the versioned block information of the
+	// BeginBlock and EndBlock sudo messages. Otherwise, the messages only contain
+	// the block and app hashes.
+	BlockInfo bool `protobuf:"varint,5,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
+}
+
+func (m *HookRegistration) Reset()         { *m = HookRegistration{} }
+func (m *HookRegistration) String() string { return proto.CompactTextString(m) }
+func (*HookRegistration) ProtoMessage()    {}
+func (*HookRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5add0b76ad5fde9, []int{8}
+}
+func (m *HookRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookRegistration.Merge(m, src)
+}
+func (m *HookRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookRegistration proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylonchain.babylon.v1beta1.Params")
+	proto.RegisterType((*HookSubscription)(nil), "babylonchain.babylon.v1beta1.HookSubscription")
+	proto.RegisterType((*HookStatus)(nil), "babylonchain.babylon.v1beta1.HookStatus")
+	proto.RegisterType((*IndexedHeader)(nil), "babylonchain.babylon.v1beta1.IndexedHeader")
+	proto.RegisterType((*ContractAuthorization)(nil), "babylonchain.babylon.v1beta1.ContractAuthorization")
+	proto.RegisterType((*StakingMsgPolicy)(nil), "babylonchain.babylon.v1beta1.StakingMsgPolicy")
+	proto.RegisterType((*ConsumerValidator)(nil), "babylonchain.babylon.v1beta1.ConsumerValidator")
+	proto.RegisterType((*CodePins)(nil), "babylonchain.babylon.v1beta1.CodePins")
+	proto.RegisterType((*HookRegistration)(nil), "babylonchain.babylon.v1beta1.HookRegistration")
 }
 
 func init() {
@@ -77,27 +502,79 @@ func init() {
 }
 
 var fileDescriptor_b5add0b76ad5fde9 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x72, 0xf4, 0xcb, 0x0c, 0x93, 0x52,
-	0x4b, 0x12, 0x0d, 0x61, 0x7c, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x19, 0x64, 0xb5, 0x7a,
-	0x30, 0x39, 0xa8, 0x5a, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0xb0, 0x5a, 0x7d,
-	0x08, 0x07, 0xa2, 0x51, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x22, 0x0e, 0x62, 0x41, 0x44, 0x95,
-	0x3e, 0x30, 0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x05, 0x71, 0x49, 0x40, 0x8d,
-	0x8b, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x89, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0xa8,
-	0x23, 0x44, 0x26, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x48, 0x0c, 0xaa, 0xd3, 0x19, 0xaa, 0x11,
-	0x2a, 0x2b, 0x14, 0xc9, 0x25, 0x93, 0x54, 0x92, 0x1c, 0x5f, 0x5c, 0x92, 0x98, 0x9d, 0x99, 0x97,
-	0x8e, 0x69, 0x2e, 0x13, 0x01, 0x73, 0x25, 0x93, 0x4a, 0x92, 0x83, 0x21, 0x9a, 0xd1, 0x8d, 0x36,
-	0xe4, 0x12, 0xcd, 0x4d, 0xac, 0x88, 0x4f, 0x4f, 0x2c, 0x8e, 0x4f, 0x4a, 0x4d, 0xcf, 0xcc, 0x8b,
-	0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x4e, 0x2d, 0x92, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x0d, 0x12, 0xca,
-	0x4d, 0xac, 0x70, 0x4f, 0x2c, 0x76, 0x02, 0x49, 0x39, 0x41, 0x64, 0xac, 0x58, 0x5e, 0x2c, 0x90,
-	0x67, 0x74, 0x0a, 0x3d, 0xf1, 0x50, 0x8e, 0x61, 0xc5, 0x23, 0x39, 0x86, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0xc7, 0x16, 0x35, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x15, 0xf0, 0x88, 0x2a, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0xa8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x8c, 0xaf,
-	0xfc, 0xcd, 0x01, 0x00, 0x00,
+	// 1152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x1b, 0x15, 0x23, 0xc5, 0xa1, 0xc6, 0x32, 0x22, 0x4d, 0x6c, 0xff, 0xb4, 0x6c, 0x4b, 0xfa, 0xdd,
+	0x8d, 0x10, 0xc0, 0x12, 0x9c, 0x00, 0x45, 0x51, 0x74, 0x51, 0x4b, 0x49, 0xeb, 0xb4, 0x29, 0x6a,
+	0x8c, 0x9d, 0x00, 0xed, 0x86, 0x18, 0x92, 0x63, 0x92, 0x15, 0xc9, 0x21, 0x38, 0x43, 0x5b, 0xee,
+	0xa6, 0xaf, 0x10, 0xa0, 0x40, 0xd7, 0x5d, 0xf6, 0x01, 0xf2, 0x10, 0xee, 0x2e, 0x48, 0x37, 0x05,
+	0x0a, 0xb4, 0x89, 0xbd, 0xe9, 0x63, 0x14, 0x73, 0x21, 0x2d, 0xcb, 0x41, 0x8c, 0x02, 0xdd, 0xf1,
+	0xbb, 0x9f, 0x6f, 0x74, 0xe6, 0x8c, 0xc0, 0x7d, 0x07, 0x3b, 0xa7, 0x11, 0x4d, 0xdc, 0x00, 0x87,
+	0xc9, 0x50, 0x1b, 0xc3, 0xe3, 0x1d, 0x87, 0x70, 0xbc, 0x53, 0xd8, 0x83, 0x34, 0xa3, 0x9c, 0xc2,
+	0x8d, 0xd9, 0xdc, 0x41, 0x11, 0xd3, 0xb9, 0xed, 0x35, 0x97, 0xb2, 0x98, 0x32, 0x5b, 0xe6, 0x0e,
+	0x95, 0xa1, 0x0a, 0xdb, 0xcb, 0x3e, 0xf5, 0xa9, 0xf2, 0x8b, 0x2f, 0xed, 0xed, 0xfa, 0x94, 0xfa,
+	0x11, 0x19, 0x4a, 0xcb, 0xc9, 0x8f, 0x86, 0x3c, 0x8c, 0x09, 0xe3, 0x38, 0x4e, 0x55, 0xc2, 0xd6,
+	0xdb, 0xdb, 0x60, 0x61, 0x1f, 0x67, 0x38, 0x66, 0x10, 0x01, 0x4b, 0xcf, 0xb3, 0x5d, 0x9a, 0xf0,
+	0x0c, 0xbb, 0xdc, 0xc6, 0x9e, 0x97, 0x11, 0xc6, 0x2c, 0xa3, 0x67, 0xf4, 0xeb, 0x23, 0xeb, 0xf5,
+	0xcb, 0xed, 0x65, 0x3d, 0x75, 0x57, 0x45, 0x0e, 0x78, 0x16, 0x26, 0x3e, 0x5a, 0xd5, 0x95, 0x63,
+	0x5d, 0xa8, 0xa3, 0xf0, 0x1b, 0xb0, 0xe1, 0x70, 0xd7, 0x66, 0x1c, 0x4f, 0xc2, 0xc4, 0xbf, 0xde,
+	0xf7, 0xd6, 0x0d, 0x7d, 0xd7, 0x1c, 0xee, 0x1e, 0xa8, 0xe2, 0xf9, 0xd6, 0x3b, 0x60, 0x25, 0xc6,
+	0x53, 0xdb, 0xc7, 0xcc, 0x76, 0x88, 0x1f, 0x26, 0xb6, 0x13, 0x51, 0x77, 0x42, 0x32, 0xab, 0xda,
+	0x33, 0xfa, 0x4b, 0x08, 0xc6, 0x78, 0xfa, 0x39, 0x66, 0x23, 0x11, 0x1a, 0xa9, 0x08, 0x1c, 0x82,
+	0xe5, 0x00, 0x47, 0xdc, 0xa6, 0x89, 0x1d, 0x50, 0x3a, 0xb1, 0x8f, 0x70, 0x18, 0xe5, 0x19, 0xb1,
+	0x6a, 0x3d, 0xa3, 0x6f, 0xa2, 0x96, 0x88, 0x7d, 0x9d, 0xec, 0x51, 0x3a, 0xf9, 0x4c, 0x05, 0xe0,
+	0x2e, 0xd8, 0x14, 0x33, 0x5c, 0x9a, 0x30, 0xe2, 0xe6, 0x3c, 0x3c, 0x26, 0x57, 0x0a, 0x99, 0x75,
+	0x5b, 0xce, 0x6a, 0xc7, 0x78, 0x3a, 0xbe, 0xcc, 0x99, 0xe9, 0xc0, 0xe0, 0x36, 0xb8, 0x57, 0xc0,
+	0x24, 0x89, 0x57, 0x82, 0x5c, 0x90, 0x85, 0x4d, 0x05, 0xf2, 0x71, 0xe2, 0x15, 0x10, 0x5d, 0x00,
+	0xe5, 0x04, 0x96, 0x3b, 0xcc, 0xcd, 0xc2, 0x94, 0x87, 0x34, 0x61, 0x96, 0xd9, 0xab, 0xf6, 0x17,
+	0x1f, 0x0c, 0x06, 0xef, 0x23, 0xc7, 0x40, 0x8c, 0x3d, 0x98, 0x29, 0x1b, 0xd5, 0xce, 0xfe, 0xec,
+	0x56, 0x50, 0x2b, 0x98, 0xf3, 0x33, 0xf8, 0x21, 0xf8, 0x5f, 0x40, 0xb0, 0x47, 0x32, 0x3b, 0x23,
+	0x9c, 0x24, 0xc2, 0xa9, 0x80, 0x31, 0xab, 0x2e, 0x71, 0xad, 0xa8, 0x30, 0x2a, 0xa2, 0x12, 0x1d,
+	0x83, 0x1f, 0x80, 0xa5, 0x8c, 0x9c, 0xe0, 0xcc, 0x63, 0xb6, 0x47, 0x12, 0x1a, 0x5b, 0x40, 0xfc,
+	0x7c, 0xa8, 0xa1, 0x9d, 0x8f, 0x84, 0x0f, 0xde, 0x07, 0x2d, 0xb1, 0xb0, 0xec, 0x67, 0xeb, 0x88,
+	0xb5, 0xd8, 0x33, 0xfa, 0x35, 0x74, 0x37, 0xc6, 0x53, 0xd9, 0x0a, 0x29, 0x37, 0xfc, 0x54, 0x9d,
+	0x2f, 0x73, 0x03, 0xe2, 0xe5, 0x11, 0xf1, 0x6c, 0x8e, 0xd9, 0x84, 0xd9, 0x29, 0xc9, 0x54, 0xbd,
+	0xd5, 0x90, 0x70, 0xd6, 0x62, 0x3c, 0x3d, 0x28, 0x72, 0x0e, 0x45, 0xca, 0x3e, 0xc9, 0x64, 0xa3,
+	0x8f, 0x6b, 0x7f, 0xff, 0xdc, 0x35, 0xbe, 0xa8, 0x99, 0x77, 0x9a, 0x26, 0x5a, 0xc5, 0x51, 0x44,
+	0x4f, 0x88, 0x67, 0xbb, 0xd4, 0x23, 0xb6, 0x1b, 0x10, 0x77, 0xc2, 0xf2, 0x98, 0x6d, 0xfd, 0x66,
+	0x80, 0xe6, 0xfc, 0xe1, 0xc0, 0x36, 0x30, 0x0b, 0x36, 0x2a, 0x76, 0xa3, 0xd2, 0x86, 0x5d, 0xb0,
+	0x38, 0x43, 0x29, 0x49, 0x52, 0x13, 0x01, 0xa7, 0xa4, 0x12, 0x5c, 0x07, 0xf5, 0xf2, 0xc7, 0x94,
+	0x7c, 0x33, 0x91, 0x49, 0x12, 0xaf, 0x0c, 0x8a, 0x5f, 0x3b, 0x0a, 0xe3, 0x90, 0x4b, 0x6a, 0x2d,
+	0x21, 0xd3, 0xc7, 0xec, 0xa9, 0xb0, 0xe1, 0x26, 0x00, 0xea, 0x64, 0xc2, 0xe4, 0x88, 0x4a, 0xfa,
+	0x98, 0xa8, 0x2e, 0x3d, 0x4f, 0x92, 0x23, 0x2a, 0x4e, 0xf8, 0x18, 0x47, 0x8c, 0x70, 0x3b, 0x4f,
+	0x3d, 0xcc, 0x89, 0xe4, 0x89, 0x89, 0x1a, 0xca, 0xf9, 0x4c, 0xfa, 0xd4, 0xce, 0x5b, 0x6f, 0x0c,
+	0x00, 0xe4, 0x56, 0x1c, 0xf3, 0x5c, 0x5c, 0x87, 0xe5, 0x59, 0x9a, 0x96, 0x0c, 0x35, 0xe4, 0xc9,
+	0xdf, 0x9b, 0x89, 0x95, 0xd4, 0xdc, 0x00, 0x75, 0x96, 0xb3, 0x94, 0x24, 0x1e, 0xf1, 0xf4, 0x92,
+	0x97, 0x0e, 0x38, 0x00, 0xf7, 0x4a, 0xc3, 0xc6, 0xdc, 0x0e, 0x48, 0xe8, 0x07, 0x5c, 0x6e, 0x5b,
+	0x45, 0xad, 0x32, 0xb4, 0xcb, 0xf7, 0x64, 0x00, 0x7e, 0x09, 0xb6, 0x66, 0x01, 0x5c, 0x59, 0xe3,
+	0x12, 0x4e, 0x4d, 0xc2, 0xe9, 0xce, 0x64, 0x3e, 0x9f, 0x59, 0xad, 0x80, 0xa6, 0x57, 0xfc, 0xc9,
+	0x00, 0x4b, 0x4f, 0x12, 0x8f, 0x4c, 0x89, 0xb7, 0x27, 0x09, 0x09, 0x57, 0xc1, 0x82, 0xc6, 0x61,
+	0x48, 0x1c, 0xda, 0x82, 0x10, 0xd4, 0x02, 0xcc, 0x02, 0xb9, 0x45, 0x03, 0xc9, 0x6f, 0xb8, 0x06,
+	0x4c, 0x9c, 0xa6, 0xb6, 0xf4, 0x57, 0xa5, 0xff, 0x0e, 0x4e, 0xd3, 0x3d, 0x11, 0xfa, 0x08, 0xd4,
+	0x84, 0x10, 0x4a, 0x34, 0x8b, 0x0f, 0xda, 0x03, 0xa5, 0x92, 0x83, 0x42, 0x25, 0x07, 0x87, 0x85,
+	0x4a, 0x8e, 0x4c, 0x71, 0x87, 0x5e, 0xfc, 0xd5, 0x35, 0x90, 0xac, 0xd0, 0xc0, 0x7e, 0x00, 0x2b,
+	0xa5, 0x1c, 0xe5, 0x3c, 0xa0, 0x59, 0xf8, 0x3d, 0x96, 0xac, 0x1a, 0x83, 0xe6, 0xbf, 0xd6, 0xce,
+	0xbb, 0xee, 0x9c, 0xb2, 0xad, 0x83, 0x7a, 0xcc, 0x7c, 0x9b, 0x9f, 0xa6, 0x44, 0x28, 0x64, 0x55,
+	0x70, 0x33, 0x66, 0xfe, 0xa1, 0xb0, 0x35, 0x80, 0x5f, 0x0d, 0xd0, 0xd4, 0xba, 0xf8, 0x15, 0xf3,
+	0xf7, 0x69, 0x14, 0xba, 0xa7, 0x82, 0x3c, 0x1e, 0x39, 0xc2, 0x79, 0xc4, 0x6d, 0x79, 0x13, 0xe4,
+	0x64, 0x13, 0x35, 0xb4, 0x73, 0x57, 0xf8, 0xe0, 0x63, 0xd0, 0xba, 0xbc, 0x26, 0x6a, 0xae, 0x1e,
+	0xf2, 0x1e, 0x88, 0x4d, 0x5d, 0x52, 0x2c, 0xcd, 0xc4, 0xa2, 0x1e, 0x49, 0xc2, 0x2b, 0x5d, 0xaa,
+	0x37, 0x74, 0xb9, 0xab, 0x2a, 0xca, 0x26, 0x7a, 0x97, 0x3f, 0x0c, 0xd0, 0x12, 0xea, 0x99, 0xc7,
+	0x24, 0x7b, 0x8e, 0xa3, 0xd0, 0xc3, 0x9c, 0x66, 0xf0, 0x29, 0x68, 0xd2, 0x94, 0x64, 0xe2, 0x7b,
+	0xee, 0x24, 0xff, 0xff, 0xfa, 0xe5, 0xf6, 0xa6, 0x1e, 0x50, 0xe6, 0xcf, 0x4d, 0x2a, 0x4a, 0x8b,
+	0x23, 0x7d, 0x04, 0x1a, 0x82, 0x72, 0x73, 0xef, 0xce, 0x6c, 0x27, 0xa9, 0xdf, 0x09, 0xcb, 0xd9,
+	0xd5, 0x4e, 0x8b, 0xa2, 0xac, 0xe8, 0xb2, 0x0c, 0x6e, 0xa7, 0xf4, 0x44, 0x3f, 0x31, 0x55, 0xa4,
+	0x0c, 0xc1, 0xc9, 0xef, 0x70, 0x18, 0x11, 0x4f, 0xbf, 0x23, 0xda, 0xd2, 0xdb, 0xfd, 0x68, 0x00,
+	0x73, 0x4c, 0x3d, 0xb2, 0x1f, 0x26, 0x0c, 0x7e, 0x02, 0xda, 0xd7, 0x9e, 0xd8, 0x52, 0xa7, 0x2c,
+	0xa3, 0x57, 0xed, 0x37, 0x90, 0x35, 0xf7, 0x94, 0x8e, 0x8b, 0x38, 0x1c, 0x83, 0xce, 0x3b, 0x1f,
+	0xd3, 0xcb, 0x0e, 0xb7, 0x64, 0x87, 0xf5, 0xeb, 0x8f, 0x66, 0xd9, 0x64, 0x86, 0x3f, 0x42, 0x3c,
+	0x10, 0xf1, 0x43, 0xc6, 0xb3, 0xff, 0x90, 0xbc, 0xe2, 0x26, 0x52, 0xaa, 0x44, 0xb3, 0x8e, 0xe4,
+	0xf7, 0x55, 0x45, 0xac, 0xce, 0x29, 0x62, 0x1b, 0x98, 0x69, 0x16, 0xd2, 0x2c, 0xe4, 0xa7, 0x85,
+	0x5a, 0x16, 0xf6, 0x0d, 0x6a, 0xa9, 0x76, 0x19, 0x3d, 0x3b, 0x7b, 0xdb, 0xa9, 0xfc, 0x72, 0xde,
+	0xa9, 0x9c, 0x9d, 0x77, 0x8c, 0x57, 0xe7, 0x1d, 0xe3, 0xcd, 0x79, 0xc7, 0x78, 0x71, 0xd1, 0xa9,
+	0xbc, 0xba, 0xe8, 0x54, 0x7e, 0xbf, 0xe8, 0x54, 0xbe, 0x7d, 0xe8, 0x87, 0x3c, 0xc8, 0x9d, 0x81,
+	0x4b, 0xe3, 0xe1, 0xbb, 0xfe, 0x8b, 0x6d, 0x33, 0x6f, 0x32, 0x9c, 0x16, 0xd6, 0x50, 0x5e, 0x3c,
+	0x67, 0x41, 0xaa, 0xc1, 0xc3, 0x7f, 0x06, 0x00, 0x4d, 0xe7, 0x3b, 0x49, 0xbe, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -114,102 +591,2402 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BabylonContractAddress != that1.BabylonContractAddress {
-		return false
-	}
-	if this.BtcStakingContractAddress != that1.BtcStakingContractAddress {
-		return false
-	}
-	if this.MaxGasBeginBlocker != that1.MaxGasBeginBlocker {
-		return false
-	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxGasBeginBlocker != 0 {
-		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasBeginBlocker))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BtcStakingContractAddress) > 0 {
-		i -= len(m.BtcStakingContractAddress)
-		copy(dAtA[i:], m.BtcStakingContractAddress)
-		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcStakingContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BabylonContractAddress) > 0 {
-		i -= len(m.BabylonContractAddress)
-		copy(dAtA[i:], m.BabylonContractAddress)
-		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BabylonContractAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BabylonContractAddress != that1.BabylonContractAddress {
+		return false
+	}
+	if this.BtcStakingContractAddress != that1.BtcStakingContractAddress {
+		return false
+	}
+	if this.MaxGasBeginBlocker != that1.MaxGasBeginBlocker {
+		return false
+	}
+	if this.HaltOnHookFailure != that1.HaltOnHookFailure {
+		return false
+	}
+	if this.MaxConsecutiveHookFailures != that1.MaxConsecutiveHookFailures {
+		return false
+	}
+	if this.MaxGasEndBlocker != that1.MaxGasEndBlocker {
+		return false
+	}
+	if len(this.HookSubscriptions) != len(that1.HookSubscriptions) {
+		return false
+	}
+	for i := range this.HookSubscriptions {
+		if !this.HookSubscriptions[i].Equal(&that1.HookSubscriptions[i]) {
+			return false
+		}
+	}
+	if this.HeaderRetentionBlocks != that1.HeaderRetentionBlocks {
+		return false
+	}
+	if this.RewardsDenom != that1.RewardsDenom {
+		return false
+	}
+	if this.MaxBlockRewards != that1.MaxBlockRewards {
+		return false
+	}
+	if this.MaxScheduledTasksPerBlock != that1.MaxScheduledTasksPerBlock {
+		return false
+	}
+	return true
+}
+func (this *HookSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HookSubscription)
+	if !ok {
+		that2, ok := that.(HookSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.BeginBlock != that1.BeginBlock {
+		return false
+	}
+	if this.EndBlock != that1.EndBlock {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.BlockInfo != that1.BlockInfo {
+		return false
+	}
+	if this.ValsetUpdate != that1.ValsetUpdate {
+		return false
+	}
+	return true
+}
+func (this *HookStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HookStatus)
+	if !ok {
+		that2, ok := that.(HookStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	if this.SuspendedAtHeight != that1.SuspendedAtHeight {
+		return false
+	}
+	if this.ConsecutiveValsetUpdateFailures != that1.ConsecutiveValsetUpdateFailures {
+		return false
+	}
+	return true
+}
+func (this *IndexedHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedHeader)
+	if !ok {
+		that2, ok := that.(IndexedHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.AppHash, that1.AppHash) {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *ContractAuthorization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractAuthorization)
+	if !ok {
+		that2, ok := that.(ContractAuthorization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if len(this.MsgTypes) != len(that1.MsgTypes) {
+		return false
+	}
+	for i := range this.MsgTypes {
+		if this.MsgTypes[i] != that1.MsgTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *StakingMsgPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakingMsgPolicy)
+	if !ok {
+		that2, ok := that.(StakingMsgPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DefaultAllow != that1.DefaultAllow {
+		return false
+	}
+	if len(this.AllowedContracts) != len(that1.AllowedContracts) {
+		return false
+	}
+	for i := range this.AllowedContracts {
+		if this.AllowedContracts[i] != that1.AllowedContracts[i] {
+			return false
+		}
+	}
+	if len(this.DeniedContracts) != len(that1.DeniedContracts) {
+		return false
+	}
+	for i := range this.DeniedContracts {
+		if this.DeniedContracts[i] != that1.DeniedContracts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ConsumerValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsumerValidator)
+	if !ok {
+		that2, ok := that.(ConsumerValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OperatorAddress != that1.OperatorAddress {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.Jailed != that1.Jailed {
+		return false
+	}
+	return true
+}
+func (this *CodePins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodePins)
+	if !ok {
+		that2, ok := that.(CodePins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BabylonContractChecksums) != len(that1.BabylonContractChecksums) {
+		return false
+	}
+	for i := range this.BabylonContractChecksums {
+		if !bytes.Equal(this.BabylonContractChecksums[i], that1.BabylonContractChecksums[i]) {
+			return false
+		}
+	}
+	if len(this.BtcStakingContractChecksums) != len(that1.BtcStakingContractChecksums) {
+		return false
+	}
+	for i := range this.BtcStakingContractChecksums {
+		if !bytes.Equal(this.BtcStakingContractChecksums[i], that1.BtcStakingContractChecksums[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HookRegistration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HookRegistration)
+	if !ok {
+		that2, ok := that.(HookRegistration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Hook != that1.Hook {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.BlockInfo != that1.BlockInfo {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxScheduledTasksPerBlock != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxScheduledTasksPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxBlockRewards != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxBlockRewards))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RewardsDenom) > 0 {
+		i -= len(m.RewardsDenom)
+		copy(dAtA[i:], m.RewardsDenom)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.RewardsDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.HeaderRetentionBlocks != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.HeaderRetentionBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.HookSubscriptions) > 0 {
+		for iNdEx := len(m.HookSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxGasEndBlocker != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasEndBlocker))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxConsecutiveHookFailures != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxConsecutiveHookFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HaltOnHookFailure {
+		i--
+		if m.HaltOnHookFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasBeginBlocker != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasBeginBlocker))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BtcStakingContractAddress) > 0 {
+		i -= len(m.BtcStakingContractAddress)
+		copy(dAtA[i:], m.BtcStakingContractAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcStakingContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BabylonContractAddress) > 0 {
+		i -= len(m.BabylonContractAddress)
+		copy(dAtA[i:], m.BabylonContractAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BabylonContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdate {
+		i--
+		if m.ValsetUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockInfo {
+		i--
+		if m.BlockInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndBlock {
+		i--
+		if m.EndBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeginBlock {
+		i--
+		if m.BeginBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveValsetUpdateFailures != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.ConsecutiveValsetUpdateFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SuspendedAtHeight != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.SuspendedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBabylon(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingMsgPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingMsgPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingMsgPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedContracts) > 0 {
+		for iNdEx := len(m.DeniedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedContracts[iNdEx])
+			copy(dAtA[i:], m.DeniedContracts[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.DeniedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultAllow {
+		i--
+		if m.DefaultAllow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodePins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodePins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodePins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcStakingContractChecksums) > 0 {
+		for iNdEx := len(m.BtcStakingContractChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BtcStakingContractChecksums[iNdEx])
+			copy(dAtA[i:], m.BtcStakingContractChecksums[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcStakingContractChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BabylonContractChecksums) > 0 {
+		for iNdEx := len(m.BabylonContractChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BabylonContractChecksums[iNdEx])
+			copy(dAtA[i:], m.BabylonContractChecksums[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.BabylonContractChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockInfo {
+		i--
+		if m.BlockInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBabylon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBabylon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BabylonContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.BtcStakingContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.MaxGasBeginBlocker != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGasBeginBlocker))
+	}
+	if m.HaltOnHookFailure {
+		n += 2
+	}
+	if m.MaxConsecutiveHookFailures != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxConsecutiveHookFailures))
+	}
+	if m.MaxGasEndBlocker != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGasEndBlocker))
+	}
+	if len(m.HookSubscriptions) > 0 {
+		for _, e := range m.HookSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if m.HeaderRetentionBlocks != 0 {
+		n += 1 + sovBabylon(uint64(m.HeaderRetentionBlocks))
+	}
+	l = len(m.RewardsDenom)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.MaxBlockRewards != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxBlockRewards))
+	}
+	if m.MaxScheduledTasksPerBlock != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxScheduledTasksPerBlock))
+	}
+	return n
+}
+
+func (m *HookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.BeginBlock {
+		n += 2
+	}
+	if m.EndBlock {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBabylon(uint64(m.GasLimit))
+	}
+	if m.BlockInfo {
+		n += 2
+	}
+	if m.ValsetUpdate {
+		n += 2
+	}
+	return n
+}
+
+func (m *HookStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovBabylon(uint64(m.ConsecutiveFailures))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.SuspendedAtHeight != 0 {
+		n += 1 + sovBabylon(uint64(m.SuspendedAtHeight))
+	}
+	if m.ConsecutiveValsetUpdateFailures != 0 {
+		n += 1 + sovBabylon(uint64(m.ConsecutiveValsetUpdateFailures))
+	}
+	return n
+}
+
+func (m *IndexedHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBabylon(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBabylon(uint64(l))
+	return n
+}
+
+func (m *ContractAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingMsgPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultAllow {
+		n += 2
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if len(m.DeniedContracts) > 0 {
+		for _, s := range m.DeniedContracts {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovBabylon(uint64(m.Power))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *CodePins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BabylonContractChecksums) > 0 {
+		for _, b := range m.BabylonContractChecksums {
+			l = len(b)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if len(m.BtcStakingContractChecksums) > 0 {
+		for _, b := range m.BtcStakingContractChecksums {
+			l = len(b)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HookRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBabylon(uint64(m.GasLimit))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovBabylon(uint64(m.Priority))
+	}
+	if m.BlockInfo {
+		n += 2
+	}
+	return n
+}
+
+func sovBabylon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBabylon(x uint64) (n int) {
+	return sovBabylon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasBeginBlocker", wireType)
+			}
+			m.MaxGasBeginBlocker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasBeginBlocker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltOnHookFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HaltOnHookFailure = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveHookFailures", wireType)
+			}
+			m.MaxConsecutiveHookFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveHookFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasEndBlocker", wireType)
+			}
+			m.MaxGasEndBlocker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasEndBlocker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookSubscriptions = append(m.HookSubscriptions, HookSubscription{})
+			if err := m.HookSubscriptions[len(m.HookSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRetentionBlocks", wireType)
+			}
+			m.HeaderRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRetentionBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockRewards", wireType)
+			}
+			m.MaxBlockRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledTasksPerBlock", wireType)
+			}
+			m.MaxScheduledTasksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledTasksPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeginBlock = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndBlock = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockInfo = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValsetUpdate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAtHeight", wireType)
+			}
+			m.SuspendedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveValsetUpdateFailures", wireType)
+			}
+			m.ConsecutiveValsetUpdateFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveValsetUpdateFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingMsgPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingMsgPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingMsgPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAllow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultAllow = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedContracts = append(m.DeniedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintBabylon(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBabylon(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BabylonContractAddress)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
-	}
-	l = len(m.BtcStakingContractAddress)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
-	}
-	if m.MaxGasBeginBlocker != 0 {
-		n += 1 + sovBabylon(uint64(m.MaxGasBeginBlocker))
+func (m *CodePins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodePins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodePins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonContractChecksums = append(m.BabylonContractChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.BabylonContractChecksums[len(m.BabylonContractChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingContractChecksums = append(m.BtcStakingContractChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.BtcStakingContractChecksums[len(m.BtcStakingContractChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBabylon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBabylon(x uint64) (n int) {
-	return sovBabylon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *HookRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -232,15 +3009,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -268,11 +3045,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BabylonContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -300,13 +3077,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcStakingContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Hook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasBeginBlocker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.MaxGasBeginBlocker = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -316,11 +3093,50 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGasBeginBlocker |= uint32(b&0x7F) << shift
+				m.GasLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BlockInfo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBabylon(dAtA[iNdEx:])
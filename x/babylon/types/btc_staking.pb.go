@@ -0,0 +1,1822 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylonchain/babylon/v1beta1/btc_staking.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FinalityProvider is a finality provider as stored in the BTC staking
+// contract
+type FinalityProvider struct {
+	// btc_pk_hex is the Bitcoin secp256k1 public key of the finality provider in
+	// hex
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// description defines the description terms for the finality provider
+	Description *FinalityProviderDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider as decimal
+	// string
+	Commission string `protobuf:"bytes,3,opt,name=commission,proto3" json:"commission,omitempty"`
+	// consumer_id is the ID of the consumer chain the finality provider is
+	// securing
+	ConsumerId string `protobuf:"bytes,4,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// slashed_babylon_height is the Babylon height at which the finality
+	// provider was slashed, zero if not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,5,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height is the Bitcoin height at which the finality provider
+	// was slashed, zero if not slashed
+	SlashedBtcHeight uint64 `protobuf:"varint,6,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+}
+
+func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
+func (m *FinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*FinalityProvider) ProtoMessage()    {}
+func (*FinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b94d65b232ab8b, []int{0}
+}
+func (m *FinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProvider.Merge(m, src)
+}
+func (m *FinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProvider proto.InternalMessageInfo
+
+// FinalityProviderDescription defines the description terms of a finality
+// provider
+type FinalityProviderDescription struct {
+	Moniker         string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Identity        string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Website         string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+	Details         string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *FinalityProviderDescription) Reset()         { *m = FinalityProviderDescription{} }
+func (m *FinalityProviderDescription) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderDescription) ProtoMessage()    {}
+func (*FinalityProviderDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b94d65b232ab8b, []int{1}
+}
+func (m *FinalityProviderDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderDescription.Merge(m, src)
+}
+func (m *FinalityProviderDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderDescription proto.InternalMessageInfo
+
+// BTCDelegation is a BTC delegation as stored in the BTC staking contract
+type BTCDelegation struct {
+	// staking_tx_hash_hex is the hash of the staking transaction in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// btc_pk_hex is the Bitcoin secp256k1 public key of the delegator in hex
+	BtcPkHex string `protobuf:"bytes,2,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// fp_btc_pk_list is the list of Bitcoin public keys in hex of the finality
+	// providers that the delegation is restaked to
+	FpBtcPkList []string `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3" json:"fp_btc_pk_list,omitempty"`
+	// start_height is the Bitcoin height at which the delegation starts
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the Bitcoin height at which the delegation ends
+	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stake in satoshis
+	TotalSat uint64 `protobuf:"varint,6,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx is the staking transaction
+	StakingTx []byte `protobuf:"bytes,7,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// slashing_tx is the slashing transaction
+	SlashingTx []byte `protobuf:"bytes,8,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking
+	// transaction
+	StakingOutputIdx uint32 `protobuf:"varint,9,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// unbonding_time is the time in Bitcoin blocks that the staked BTC is locked
+	// after unbonding
+	UnbondingTime uint32 `protobuf:"varint,10,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// params_version is the version of the BTC staking parameters the delegation
+	// was created under
+	ParamsVersion uint32 `protobuf:"varint,11,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+}
+
+func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
+func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegation) ProtoMessage()    {}
+func (*BTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b94d65b232ab8b, []int{2}
+}
+func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegation.Merge(m, src)
+}
+func (m *BTCDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegation proto.InternalMessageInfo
+
+// IndexedBlock is a consumer chain block as indexed by the BTC staking
+// contract
+type IndexedBlock struct {
+	// height is the height of the block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// app_hash is the app hash of the block
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// finalized indicates whether the block is finalized by BTC staking
+	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *IndexedBlock) Reset()         { *m = IndexedBlock{} }
+func (m *IndexedBlock) String() string { return proto.CompactTextString(m) }
+func (*IndexedBlock) ProtoMessage()    {}
+func (*IndexedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b94d65b232ab8b, []int{3}
+}
+func (m *IndexedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedBlock.Merge(m, src)
+}
+func (m *IndexedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedBlock proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FinalityProvider)(nil), "babylonchain.babylon.v1beta1.FinalityProvider")
+	proto.RegisterType((*FinalityProviderDescription)(nil), "babylonchain.babylon.v1beta1.FinalityProviderDescription")
+	proto.RegisterType((*BTCDelegation)(nil), "babylonchain.babylon.v1beta1.BTCDelegation")
+	proto.RegisterType((*IndexedBlock)(nil), "babylonchain.babylon.v1beta1.IndexedBlock")
+}
+
+func init() {
+	proto.RegisterFile("babylonchain/babylon/v1beta1/btc_staking.proto", fileDescriptor_64b94d65b232ab8b)
+}
+
+var fileDescriptor_64b94d65b232ab8b = []byte{
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0xfc, 0x6d, 0xbc, 0x49, 0xfb, 0x47, 0xfb, 0x57, 0x95, 0xff, 0xb6, 0xb8, 0xa1,
+	0x08, 0x29, 0x48, 0x34, 0x56, 0x29, 0x17, 0x38, 0xa6, 0x15, 0x6a, 0x25, 0x24, 0x2a, 0x13, 0x38,
+	0xc0, 0xc1, 0x5a, 0x7b, 0xb7, 0xf1, 0x2a, 0xb6, 0xd7, 0xf2, 0x4e, 0x8a, 0xc3, 0x53, 0xf0, 0x08,
+	0x88, 0x13, 0x8f, 0xd0, 0x47, 0xe8, 0xb1, 0x47, 0x8e, 0x90, 0x5e, 0x78, 0x0c, 0xe4, 0xf5, 0x3a,
+	0x4d, 0x2b, 0xd4, 0x9b, 0xe7, 0x9b, 0x6f, 0x66, 0x76, 0xe6, 0xfb, 0x64, 0xd4, 0xf7, 0x89, 0x3f,
+	0x8d, 0x44, 0x12, 0x84, 0x84, 0x27, 0x8e, 0x0e, 0x9c, 0xf3, 0x7d, 0x9f, 0x01, 0xd9, 0x77, 0x7c,
+	0x08, 0x3c, 0x09, 0x64, 0xcc, 0x93, 0x51, 0x3f, 0xcd, 0x04, 0x08, 0xbc, 0xbd, 0xc8, 0xaf, 0x8a,
+	0xfb, 0x9a, 0xbf, 0xb9, 0x3e, 0x12, 0x23, 0xa1, 0x88, 0x4e, 0xf1, 0x55, 0xd6, 0xec, 0x5e, 0x2c,
+	0xa1, 0xce, 0x2b, 0x9e, 0x90, 0x88, 0xc3, 0xf4, 0x34, 0x13, 0xe7, 0x9c, 0xb2, 0x0c, 0x6f, 0x23,
+	0x54, 0x74, 0x4f, 0xc7, 0x5e, 0xc8, 0x72, 0xcb, 0xe8, 0x1a, 0x3d, 0xd3, 0x6d, 0xfa, 0x10, 0x9c,
+	0x8e, 0x8f, 0x59, 0x8e, 0x3f, 0xa2, 0x16, 0x65, 0x32, 0xc8, 0x78, 0x0a, 0x5c, 0x24, 0xd6, 0x52,
+	0xd7, 0xe8, 0xb5, 0x9e, 0xbd, 0xe8, 0xdf, 0x37, 0xbc, 0x7f, 0x77, 0xc4, 0xd1, 0x4d, 0x03, 0x77,
+	0xb1, 0x1b, 0xb6, 0x11, 0x0a, 0x44, 0x1c, 0x73, 0x29, 0x8b, 0xde, 0x75, 0x35, 0x7a, 0x01, 0xc1,
+	0x3b, 0xa8, 0x15, 0x88, 0x44, 0x4e, 0x62, 0x96, 0x79, 0x9c, 0x5a, 0x8d, 0x8a, 0x50, 0x42, 0x27,
+	0x14, 0x3f, 0x47, 0x1b, 0x32, 0x22, 0x32, 0x64, 0xd4, 0xd3, 0x8f, 0xf0, 0x42, 0xc6, 0x47, 0x21,
+	0x58, 0xff, 0x74, 0x8d, 0x5e, 0xc3, 0x5d, 0xd7, 0xd9, 0x41, 0x99, 0x3c, 0x56, 0x39, 0xfc, 0x14,
+	0xe1, 0x79, 0x15, 0x04, 0x55, 0xc5, 0xb2, 0xaa, 0xe8, 0x54, 0x15, 0x10, 0x94, 0xec, 0x97, 0x8d,
+	0xdf, 0x5f, 0x77, 0x8c, 0xdd, 0x0b, 0x03, 0x6d, 0xdd, 0xb3, 0x17, 0xb6, 0xd0, 0x4a, 0x2c, 0x12,
+	0x3e, 0x66, 0x99, 0x3e, 0x61, 0x15, 0xe2, 0x4d, 0xd4, 0xe4, 0x94, 0x25, 0xc0, 0x61, 0xaa, 0xce,
+	0x67, 0xba, 0xf3, 0xb8, 0xa8, 0xfa, 0xc4, 0x7c, 0xc9, 0x81, 0xe9, 0xed, 0xab, 0x10, 0x3f, 0x41,
+	0x1d, 0xc9, 0x82, 0x49, 0xc6, 0x61, 0xea, 0x05, 0x22, 0x01, 0x12, 0x80, 0xde, 0xff, 0xdf, 0x0a,
+	0x3f, 0x2c, 0xe1, 0xa2, 0x09, 0x65, 0x40, 0x78, 0x24, 0xd5, 0xd6, 0xa6, 0x5b, 0x85, 0xfa, 0xe9,
+	0xdf, 0xea, 0x68, 0x75, 0x30, 0x3c, 0x3c, 0x62, 0x11, 0x1b, 0x11, 0xf5, 0xd8, 0x3d, 0xf4, 0x9f,
+	0x36, 0x93, 0x07, 0xb9, 0x17, 0x12, 0x19, 0x2e, 0x68, 0xdf, 0xd1, 0xa9, 0x61, 0x7e, 0x4c, 0x64,
+	0x58, 0x78, 0xe0, 0xb6, 0x43, 0x96, 0xee, 0x38, 0xe4, 0x11, 0x5a, 0x3b, 0x4b, 0x3d, 0x4d, 0x88,
+	0xb8, 0x04, 0xab, 0xde, 0xad, 0xf7, 0x4c, 0xb7, 0x75, 0x96, 0x0e, 0x0a, 0xce, 0x6b, 0x2e, 0x01,
+	0x3f, 0x44, 0x6d, 0x09, 0x24, 0x83, 0xea, 0xd8, 0x0d, 0x75, 0xec, 0x96, 0xc2, 0xb4, 0x2a, 0x0f,
+	0x10, 0x62, 0x09, 0xbd, 0xad, 0x9f, 0xc9, 0x12, 0xaa, 0xd3, 0x5b, 0xc8, 0x04, 0x01, 0x24, 0xf2,
+	0x24, 0xa9, 0xb4, 0x6a, 0x2a, 0xe0, 0x2d, 0x51, 0xb5, 0x37, 0x0b, 0x59, 0x2b, 0x5d, 0xa3, 0xd7,
+	0x76, 0xcd, 0xf9, 0x1e, 0x85, 0x8f, 0x94, 0xac, 0x3a, 0xdf, 0x54, 0x79, 0x54, 0x41, 0xc3, 0x5c,
+	0x39, 0x42, 0xd7, 0x8b, 0x09, 0xa4, 0x13, 0xf0, 0x38, 0xcd, 0x2d, 0xb3, 0x6b, 0xf4, 0x56, 0xe7,
+	0xf7, 0x78, 0xa3, 0x12, 0x27, 0x34, 0xc7, 0x8f, 0xd1, 0xda, 0x24, 0xf1, 0x45, 0x42, 0x55, 0x3f,
+	0x1e, 0x33, 0x0b, 0x29, 0xe6, 0xea, 0x1c, 0x1d, 0xf2, 0x98, 0x15, 0xb4, 0x94, 0x64, 0x24, 0x96,
+	0xde, 0x39, 0xcb, 0x94, 0xc3, 0x5b, 0x25, 0xad, 0x44, 0xdf, 0x97, 0xa0, 0x16, 0x89, 0xa1, 0xf6,
+	0x49, 0x42, 0x59, 0xce, 0xe8, 0x20, 0x12, 0xc1, 0x18, 0x6f, 0xa0, 0x65, 0x7d, 0x09, 0x43, 0xed,
+	0xaa, 0x23, 0xfc, 0x3f, 0x6a, 0x92, 0x34, 0x55, 0x9a, 0x29, 0x25, 0xda, 0xee, 0x0a, 0x49, 0xd3,
+	0x42, 0x29, 0xbc, 0x8d, 0xcc, 0x33, 0xe5, 0xd0, 0xcf, 0x8c, 0x2a, 0x3b, 0x35, 0xdd, 0x1b, 0xa0,
+	0x1c, 0x33, 0x78, 0x77, 0xf9, 0xcb, 0xae, 0x7d, 0x9f, 0xd9, 0xb5, 0xcb, 0x99, 0x6d, 0x5c, 0xcd,
+	0x6c, 0xe3, 0xe7, 0xcc, 0x36, 0xbe, 0x5c, 0xdb, 0xb5, 0xab, 0x6b, 0xbb, 0xf6, 0xe3, 0xda, 0xae,
+	0x7d, 0x38, 0x18, 0x71, 0x08, 0x27, 0x7e, 0x3f, 0x10, 0xb1, 0xf3, 0xb7, 0x5f, 0xd2, 0x9e, 0xa4,
+	0x63, 0x27, 0xaf, 0x22, 0x07, 0xa6, 0x29, 0x93, 0xfe, 0xb2, 0xfa, 0xbf, 0x1c, 0xfc, 0x19, 0x00,
+	0x6f, 0x01, 0x85, 0x9f, 0xc5, 0x04, 0x00, 0x00,
+}
+
+func (this *FinalityProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FinalityProvider)
+	if !ok {
+		that2, ok := that.(FinalityProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BtcPkHex != that1.BtcPkHex {
+		return false
+	}
+	if !this.Description.Equal(that1.Description) {
+		return false
+	}
+	if this.Commission != that1.Commission {
+		return false
+	}
+	if this.ConsumerId != that1.ConsumerId {
+		return false
+	}
+	if this.SlashedBabylonHeight != that1.SlashedBabylonHeight {
+		return false
+	}
+	if this.SlashedBtcHeight != that1.SlashedBtcHeight {
+		return false
+	}
+	return true
+}
+func (this *FinalityProviderDescription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FinalityProviderDescription)
+	if !ok {
+		that2, ok := that.(FinalityProviderDescription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Moniker != that1.Moniker {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
+		return false
+	}
+	return true
+}
+func (this *BTCDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BTCDelegation)
+	if !ok {
+		that2, ok := that.(BTCDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StakingTxHashHex != that1.StakingTxHashHex {
+		return false
+	}
+	if this.BtcPkHex != that1.BtcPkHex {
+		return false
+	}
+	if len(this.FpBtcPkList) != len(that1.FpBtcPkList) {
+		return false
+	}
+	for i := range this.FpBtcPkList {
+		if this.FpBtcPkList[i] != that1.FpBtcPkList[i] {
+			return false
+		}
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if this.TotalSat != that1.TotalSat {
+		return false
+	}
+	if !bytes.Equal(this.StakingTx, that1.StakingTx) {
+		return false
+	}
+	if !bytes.Equal(this.SlashingTx, that1.SlashingTx) {
+		return false
+	}
+	if this.StakingOutputIdx != that1.StakingOutputIdx {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.ParamsVersion != that1.ParamsVersion {
+		return false
+	}
+	return true
+}
+func (this *IndexedBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedBlock)
+	if !ok {
+		that2, ok := that.(IndexedBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.AppHash, that1.AppHash) {
+		return false
+	}
+	if this.Finalized != that1.Finalized {
+		return false
+	}
+	return true
+}
+func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commission) > 0 {
+		i -= len(m.Commission)
+		copy(dAtA[i:], m.Commission)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.Commission)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SlashingTx) > 0 {
+		i -= len(m.SlashingTx)
+		copy(dAtA[i:], m.SlashingTx)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.SlashingTx)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkList[iNdEx])
+			i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.FpBtcPkList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintBtcStaking(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtcStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtcStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtcStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.Commission)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovBtcStaking(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovBtcStaking(uint64(m.SlashedBtcHeight))
+	}
+	return n
+}
+
+func (m *FinalityProviderDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, s := range m.FpBtcPkList {
+			l = len(s)
+			n += 1 + l + sovBtcStaking(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovBtcStaking(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBtcStaking(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovBtcStaking(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	l = len(m.SlashingTx)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovBtcStaking(uint64(m.StakingOutputIdx))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovBtcStaking(uint64(m.UnbondingTime))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovBtcStaking(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBtcStaking(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovBtcStaking(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func sovBtcStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtcStaking(x uint64) (n int) {
+	return sovBtcStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &FinalityProviderDescription{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkList = append(m.FpBtcPkList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTx = append(m.SlashingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlashingTx == nil {
+				m.SlashingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBtcStaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBtcStaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBtcStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBtcStaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBtcStaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBtcStaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBtcStaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBtcStaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBtcStaking = fmt.Errorf("proto: unexpected end of group")
+)
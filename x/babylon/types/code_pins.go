@@ -0,0 +1,42 @@
+package types
+
+import "bytes"
+
+// ValidateBasic performs basic validation on code pins.
+func (p CodePins) ValidateBasic() error {
+	if err := validateChecksums(p.BabylonContractChecksums); err != nil {
+		return ErrInvalid.Wrapf("babylon contract: %s", err)
+	}
+	if err := validateChecksums(p.BtcStakingContractChecksums); err != nil {
+		return ErrInvalid.Wrapf("btc staking contract: %s", err)
+	}
+	return nil
+}
+
+// validateChecksums ensures that all checksums have the checksum length and are unique
+func validateChecksums(checksums [][]byte) error {
+	seen := make(map[string]struct{}, len(checksums))
+	for _, c := range checksums {
+		if len(c) != ChecksumLength {
+			return ErrInvalid.Wrapf("code checksum length: expected %d, got %d", ChecksumLength, len(c))
+		}
+		if _, ok := seen[string(c)]; ok {
+			return ErrInvalid.Wrapf("duplicate code checksum: %X", c)
+		}
+		seen[string(c)] = struct{}{}
+	}
+	return nil
+}
+
+// IsPinned returns true when the checksums are empty or contain the checksum
+func IsPinned(checksums [][]byte, checksum []byte) bool {
+	if len(checksums) == 0 {
+		return true
+	}
+	for _, c := range checksums {
+		if bytes.Equal(c, checksum) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// SchedulerPhaseKey is the collections key codec of a scheduler phase. The phase is encoded as a
+// single byte to keep the layout of the scheduler and hook registry keys.
+var SchedulerPhaseKey collcodec.KeyCodec[SchedulerPhase] = schedulerPhaseKey{}
+
+type schedulerPhaseKey struct{}
+
+func (schedulerPhaseKey) Encode(buffer []byte, key SchedulerPhase) (int, error) {
+	buffer[0] = byte(key)
+	return 1, nil
+}
+
+func (schedulerPhaseKey) Decode(buffer []byte) (int, SchedulerPhase, error) {
+	if len(buffer) < 1 {
+		return 0, SchedulerPhaseUndefined, fmt.Errorf("%w: wanted at least 1, got: %d", collcodec.ErrEncoding, len(buffer))
+	}
+	return 1, SchedulerPhase(buffer[0]), nil
+}
+
+func (schedulerPhaseKey) Size(SchedulerPhase) int { return 1 }
+
+func (k schedulerPhaseKey) EncodeNonTerminal(buffer []byte, key SchedulerPhase) (int, error) {
+	return k.Encode(buffer, key)
+}
+
+func (k schedulerPhaseKey) DecodeNonTerminal(buffer []byte) (int, SchedulerPhase, error) {
+	return k.Decode(buffer)
+}
+
+func (k schedulerPhaseKey) SizeNonTerminal(key SchedulerPhase) int { return k.Size(key) }
+
+// EncodeJSON encodes the phase as the name of its block hook
+func (schedulerPhaseKey) EncodeJSON(key SchedulerPhase) ([]byte, error) {
+	return json.Marshal(key.Hook())
+}
+
+func (schedulerPhaseKey) DecodeJSON(b []byte) (SchedulerPhase, error) {
+	var hook string
+	if err := json.Unmarshal(b, &hook); err != nil {
+		return SchedulerPhaseUndefined, err
+	}
+	return SchedulerPhaseFromHook(hook)
+}
+
+func (schedulerPhaseKey) Stringify(key SchedulerPhase) string { return key.Hook() }
+
+func (schedulerPhaseKey) KeyType() string { return "babylon/SchedulerPhase" }
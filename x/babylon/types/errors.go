@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -9,4 +12,27 @@ var (
 	ErrMaxCapExceeded = errorsmod.Register(ModuleName, 2, "max cap exceeded")
 	ErrUnsupported    = errorsmod.Register(ModuleName, 3, "unsupported")
 	ErrUnknown        = errorsmod.Register(ModuleName, 4, "unknown")
+	ErrHookFailed     = errorsmod.Register(ModuleName, 5, "block hook failed")
+	ErrNotFound       = errorsmod.Register(ModuleName, 6, "not found")
 )
+
+// StakingMsgRejectedError is returned when the staking message policy rejects a staking message that a
+// contract dispatched. It is an unauthorized error that keeps the contract and the message type, so that
+// the module can report the rejection when it executes the contract itself.
+type StakingMsgRejectedError struct {
+	ContractAddress string
+	MsgType         string
+}
+
+func (e *StakingMsgRejectedError) Error() string {
+	return fmt.Sprintf("contract %s has no permission for staking message %s: %s", e.ContractAddress, e.MsgType, sdkerrors.ErrUnauthorized)
+}
+
+// Cause returns the registered error for the ABCI error code
+func (e *StakingMsgRejectedError) Cause() error {
+	return sdkerrors.ErrUnauthorized
+}
+
+func (e *StakingMsgRejectedError) Unwrap() error {
+	return sdkerrors.ErrUnauthorized
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,24 +11,51 @@ import (
 const (
 	EventTypeSchedulerExec       = "scheduler_execution"
 	EventTypeSchedulerRegistered = "scheduler_registered"
+	EventTypeSchedulerDropped    = "scheduler_task_dropped"
 	EventTypeMaxCapLimitUpdated  = "max_cap_limit_updated"
 	EventTypeUnbond              = "instant_unbond"
 	EventTypeDelegate            = "instant_delegate"
+	EventTypeSudoGasUsed         = "sudo_gas_used"
+	EventTypeHookExec            = "hook_execution"
+	EventTypeHooksSuspended      = "hooks_suspended"
+	EventTypeHooksResumed        = "hooks_resumed"
+	EventTypeMintRewards         = "mint_rewards"
+	EventTypeBurnSlashed         = "burn_slashed"
+	EventTypeStakingMsgRejected  = "staking_msg_rejected"
+	EventTypeContractsDeployed   = "babylon_contracts_instantiated"
+	EventTypeCodeNotPinned       = "unpinned_code_rejected"
+	EventTypeCodePinsUpdated     = "code_pins_updated"
+	EventTypeHookRegistered      = "hook_registered"
+	EventTypeHookDeregistered    = "hook_deregistered"
 )
 
 const (
 	AttributeKeyContractAddr         = "virtual_staking_contract"
+	AttributeKeyContractAddress      = "contract_address"
 	AttributeKeySchedulerNextExec    = "next_exececution_block"
 	AttributeKeySchedulerExecSuccess = "execution_success"
 	AttributeKeySchedulerRepeat      = "repeat"
 	AttributeKeySchedulerExecError   = "error"
 	AttributeKeyValidator            = "validator"
 	AttributeKeyDelegator            = "delegator"
+	AttributeKeySudoHook             = "hook"
+	AttributeKeyGasUsed              = "gas_used"
+	AttributeKeyGasLimit             = "gas_limit"
+	AttributeKeyHookExecSuccess      = "execution_success"
+	AttributeKeyHookExecError        = "error"
+	AttributeKeyConsecutiveFailures  = "consecutive_failures"
+	AttributeKeyRecipient            = "recipient"
+	AttributeKeyMsgType              = "msg_type"
+	AttributeKeyBabylonContract      = "babylon_contract"
+	AttributeKeyBTCStakingContract   = "btc_staking_contract"
+	AttributeKeyCodeChecksum         = "code_checksum"
+	AttributeKeyPriority             = "priority"
+	AttributeKeyHeight               = "height"
 )
 
 // EmitSchedulerExecutionEvent emits an event signalling a successful or failed scheduler execution and including the error
 // details if any.
-func EmitSchedulerExecutionEvent(ctx sdk.Context, contractAddr sdk.AccAddress, err error) {
+func EmitSchedulerExecutionEvent(ctx context.Context, contractAddr sdk.AccAddress, err error) {
 	success := err == nil
 	attributes := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
@@ -38,7 +67,7 @@ func EmitSchedulerExecutionEvent(ctx sdk.Context, contractAddr sdk.AccAddress, e
 		attributes = append(attributes, sdk.NewAttribute(AttributeKeySchedulerExecError, err.Error()))
 	}
 
-	ctx.EventManager().EmitEvent(
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeSchedulerExec,
 			attributes...,
@@ -47,8 +76,8 @@ func EmitSchedulerExecutionEvent(ctx sdk.Context, contractAddr sdk.AccAddress, e
 }
 
 // EmitSchedulerRegisteredEvent emits an event signalling a new scheduler execution is registered
-func EmitSchedulerRegisteredEvent(ctx sdk.Context, contractAddr sdk.AccAddress, nextExecBlock uint64, repeat bool) {
-	ctx.EventManager().EmitEvent(
+func EmitSchedulerRegisteredEvent(ctx context.Context, contractAddr sdk.AccAddress, nextExecBlock uint64, repeat bool) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeSchedulerRegistered,
 			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
@@ -59,9 +88,23 @@ func EmitSchedulerRegisteredEvent(ctx sdk.Context, contractAddr sdk.AccAddress,
 	)
 }
 
+// EmitSchedulerDroppedEvent emits an event signalling that repeated work of a contract was not rescheduled
+// because its execution failed
+func EmitSchedulerDroppedEvent(ctx context.Context, contractAddr sdk.AccAddress, height uint64, err error) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSchedulerDropped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(AttributeKeySchedulerExecError, err.Error()),
+		),
+	)
+}
+
 // EmitMaxCapLimitUpdatedEvent emits an event signalling that max cap limit is updated
-func EmitMaxCapLimitUpdatedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) {
-	ctx.EventManager().EmitEvent(
+func EmitMaxCapLimitUpdatedEvent(ctx context.Context, contractAddr sdk.AccAddress, amount sdk.Coin) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
 			EventTypeMaxCapLimitUpdated,
 			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
@@ -70,3 +113,165 @@ func EmitMaxCapLimitUpdatedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, a
 		),
 	)
 }
+
+// EmitHookExecutionEvent emits an event signalling a successful or failed sudo call to a contract
+// in a block hook and including the error details if any.
+func EmitHookExecutionEvent(ctx context.Context, contractAddr sdk.AccAddress, hook string, err error) {
+	success := err == nil
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+		sdk.NewAttribute(AttributeKeySudoHook, hook),
+		sdk.NewAttribute(AttributeKeyHookExecSuccess, fmt.Sprintf("%t", success)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyHookExecError, err.Error()))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeHookExec,
+			attributes...,
+		),
+	)
+}
+
+// EmitSudoGasUsedEvent emits an event with the gas consumed by a sudo call to a contract in a block hook
+func EmitSudoGasUsedEvent(ctx context.Context, contractAddr sdk.AccAddress, hook string, gasUsed, gasLimit uint64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSudoGasUsed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeySudoHook, hook),
+			sdk.NewAttribute(AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(AttributeKeyGasLimit, fmt.Sprintf("%d", gasLimit)),
+		),
+	)
+}
+
+// EmitHooksSuspendedEvent emits an event signalling that the circuit breaker suspended the block hooks of a contract
+func EmitHooksSuspendedEvent(ctx context.Context, contractAddr sdk.AccAddress, consecutiveFailures uint64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeHooksSuspended,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyConsecutiveFailures, fmt.Sprintf("%d", consecutiveFailures)),
+		),
+	)
+}
+
+// EmitHooksResumedEvent emits an event signalling that the block hooks of a contract are resumed
+func EmitHooksResumedEvent(ctx context.Context, contractAddr sdk.AccAddress) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeHooksResumed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+		),
+	)
+}
+
+// EmitMintRewardsEvent emits an event signalling that a contract minted block rewards
+func EmitMintRewardsEvent(ctx context.Context, contractAddr, recipient sdk.AccAddress, amount sdk.Coin) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMintRewards,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// EmitBurnSlashedEvent emits an event signalling that a contract burned slashed tokens
+func EmitBurnSlashedEvent(ctx context.Context, contractAddr sdk.AccAddress, amount sdk.Coin) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeBurnSlashed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// EmitStakingMsgRejectedEvent emits an event signalling that a staking message dispatched by a contract was rejected
+func EmitStakingMsgRejectedEvent(ctx context.Context, contractAddr, msgType string) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakingMsgRejected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(AttributeKeyMsgType, msgType),
+		),
+	)
+}
+
+// EmitBabylonContractsInstantiatedEvent emits an event signalling that the Babylon contracts were instantiated
+// by the module and set in the params
+func EmitBabylonContractsInstantiatedEvent(ctx context.Context, babylonAddr, btcStakingAddr sdk.AccAddress) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeContractsDeployed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyBabylonContract, babylonAddr.String()),
+			sdk.NewAttribute(AttributeKeyBTCStakingContract, btcStakingAddr.String()),
+		),
+	)
+}
+
+// EmitCodeNotPinnedEvent emits an event signalling that the block hook of a contract was not called
+// because the contract runs code outside of its pins
+func EmitCodeNotPinnedEvent(ctx context.Context, contractAddr sdk.AccAddress, hook string, checksum []byte) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeCodeNotPinned,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeySudoHook, hook),
+			sdk.NewAttribute(AttributeKeyCodeChecksum, hex.EncodeToString(checksum)),
+		),
+	)
+}
+
+// EmitCodePinsUpdatedEvent emits an event signalling that the code pins of the Babylon contracts were replaced
+func EmitCodePinsUpdatedEvent(ctx context.Context, pins CodePins) {
+	attributes := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName)}
+	for _, c := range pins.BabylonContractChecksums {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyBabylonContract, hex.EncodeToString(c)))
+	}
+	for _, c := range pins.BtcStakingContractChecksums {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyBTCStakingContract, hex.EncodeToString(c)))
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(EventTypeCodePinsUpdated, attributes...))
+}
+
+// EmitHookRegisteredEvent emits an event signalling that a contract was subscribed to a block hook in the hook registry
+func EmitHookRegisteredEvent(ctx context.Context, reg HookRegistration) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeHookRegistered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, reg.ContractAddress),
+			sdk.NewAttribute(AttributeKeySudoHook, reg.Hook),
+			sdk.NewAttribute(AttributeKeyGasLimit, fmt.Sprintf("%d", reg.GasLimit)),
+			sdk.NewAttribute(AttributeKeyPriority, fmt.Sprintf("%d", reg.Priority)),
+		),
+	)
+}
+
+// EmitHookDeregisteredEvent emits an event signalling that a contract was removed from a block hook in the hook registry
+func EmitHookDeregisteredEvent(ctx context.Context, contractAddr sdk.AccAddress, hook string) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeHookDeregistered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeySudoHook, hook),
+		),
+	)
+}
@@ -3,8 +3,11 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type BankKeeper interface {
@@ -18,6 +21,14 @@ type BankKeeper interface {
 
 // StakingKeeper expected staking keeper.
 type StakingKeeper interface {
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	PowerReduction(ctx context.Context) math.Int
+}
+
+// SlashingKeeper expected slashing keeper.
+type SlashingKeeper interface {
+	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool
 }
 
 // AccountKeeper interface contains functions for getting accounts and the module address
@@ -30,4 +41,13 @@ type AccountKeeper interface {
 type WasmKeeper interface {
 	Sudo(context context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	HasContractInfo(context context.Context, contractAddress sdk.AccAddress) bool
+	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	GetCodeInfo(ctx context.Context, codeID uint64) *wasmtypes.CodeInfo
+}
+
+// ContractOpsKeeper abstract wasm keeper for permissioned contract operations
+type ContractOpsKeeper interface {
+	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *wasmtypes.AccessConfig) (codeID uint64, checksum []byte, err error)
+	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 }
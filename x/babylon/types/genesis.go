@@ -1,9 +1,16 @@
 package types
 
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState constructor
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:           params,
+		StakingMsgPolicy: DefaultStakingMsgPolicy(),
 	}
 }
 
@@ -14,5 +21,135 @@ func DefaultGenesisState(denom string) *GenesisState {
 
 // ValidateGenesis does basic validation on genesis state
 func ValidateGenesis(gs *GenesisState) error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	seenHookStatuses := make(map[string]struct{}, len(gs.HookStatuses))
+	for _, s := range gs.HookStatuses {
+		if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+			return ErrInvalid.Wrapf("hook status contract address: %s", err)
+		}
+		if _, ok := seenHookStatuses[s.ContractAddress]; ok {
+			return ErrInvalid.Wrapf("duplicate hook status: %s", s.ContractAddress)
+		}
+		seenHookStatuses[s.ContractAddress] = struct{}{}
+	}
+	seenAuthorizations := make(map[string]struct{}, len(gs.ContractAuthorizations))
+	for _, a := range gs.ContractAuthorizations {
+		if err := a.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("contract authorization: %s", err)
+		}
+		if _, ok := seenAuthorizations[a.ContractAddress]; ok {
+			return ErrInvalid.Wrapf("duplicate contract authorization: %s", a.ContractAddress)
+		}
+		seenAuthorizations[a.ContractAddress] = struct{}{}
+	}
+	if err := gs.StakingMsgPolicy.ValidateBasic(); err != nil {
+		return ErrInvalid.Wrapf("staking msg policy: %s", err)
+	}
+	type taskKey struct {
+		contract string
+		phase    string
+		height   uint64
+	}
+	seenTasks := make(map[taskKey]struct{}, len(gs.ScheduledTasks))
+	for _, t := range gs.ScheduledTasks {
+		if _, err := sdk.AccAddressFromBech32(t.ContractAddress); err != nil {
+			return ErrInvalid.Wrapf("scheduled task contract address: %s", err)
+		}
+		if _, err := SchedulerPhaseFromHook(t.Phase); err != nil {
+			return err
+		}
+		if t.Height == 0 {
+			return ErrInvalid.Wrap("empty scheduled task height")
+		}
+		if err := t.Work.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("scheduled work: %s", err)
+		}
+		key := taskKey{contract: t.ContractAddress, phase: t.Phase, height: t.Height}
+		if _, ok := seenTasks[key]; ok {
+			return ErrInvalid.Wrapf("duplicate scheduled task: %s %s %d", t.ContractAddress, t.Phase, t.Height)
+		}
+		seenTasks[key] = struct{}{}
+	}
+	seenHeaders := make(map[int64]struct{}, len(gs.IndexedHeaders))
+	for _, h := range gs.IndexedHeaders {
+		if h.Height <= 0 {
+			return ErrInvalid.Wrapf("indexed header height: %d", h.Height)
+		}
+		if _, ok := seenHeaders[h.Height]; ok {
+			return ErrInvalid.Wrapf("duplicate indexed header: %d", h.Height)
+		}
+		seenHeaders[h.Height] = struct{}{}
+	}
+	seenValidators := make(map[string]struct{}, len(gs.ValidatorSet))
+	for _, v := range gs.ValidatorSet {
+		if _, err := sdk.ValAddressFromBech32(v.OperatorAddress); err != nil {
+			return ErrInvalid.Wrapf("validator operator address: %s", err)
+		}
+		if _, err := sdk.ConsAddressFromBech32(v.ConsAddress); err != nil {
+			return ErrInvalid.Wrapf("validator consensus address: %s", err)
+		}
+		if _, ok := seenValidators[v.OperatorAddress]; ok {
+			return ErrInvalid.Wrapf("duplicate validator: %s", v.OperatorAddress)
+		}
+		seenValidators[v.OperatorAddress] = struct{}{}
+	}
+	if err := gs.CodePins.ValidateBasic(); err != nil {
+		return ErrInvalid.Wrapf("code pins: %s", err)
+	}
+	type registrationKey struct {
+		contract string
+		hook     string
+	}
+	seenRegistrations := make(map[registrationKey]struct{}, len(gs.HookRegistrations))
+	for _, r := range gs.HookRegistrations {
+		if err := r.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("hook registration: %s", err)
+		}
+		key := registrationKey{contract: r.ContractAddress, hook: r.Hook}
+		if _, ok := seenRegistrations[key]; ok {
+			return ErrInvalid.Wrapf("duplicate hook registration: %s %s", r.ContractAddress, r.Hook)
+		}
+		seenRegistrations[key] = struct{}{}
+	}
+	if b := gs.ContractsBootstrap; b != nil {
+		if err := b.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("contracts bootstrap: %s", err)
+		}
+		if len(gs.Params.BabylonContractAddress) != 0 || len(gs.Params.BtcStakingContractAddress) != 0 {
+			return ErrInvalid.Wrap("contracts bootstrap conflicts with contract addresses in params")
+		}
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation of the contracts bootstrap
+func (b ContractsBootstrap) ValidateBasic() error {
+	if (len(b.BabylonContractCode) == 0) == (b.BabylonContractCodeId == 0) {
+		return ErrInvalid.Wrap("exactly one of babylon contract code or code id required")
+	}
+	if (len(b.BtcStakingContractCode) == 0) == (b.BtcStakingContractCodeId == 0) {
+		return ErrInvalid.Wrap("exactly one of btc staking contract code or code id required")
+	}
+	if len(b.BabylonInitMsg) != 0 {
+		if err := b.BabylonInitMsg.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("babylon init msg: %s", err)
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(b.BabylonInitMsg, &fields); err != nil {
+			return ErrInvalid.Wrapf("babylon init msg: %s", err)
+		}
+	}
+	if len(b.BtcStakingInitMsg) != 0 {
+		if err := b.BtcStakingInitMsg.ValidateBasic(); err != nil {
+			return ErrInvalid.Wrapf("btc staking init msg: %s", err)
+		}
+	}
+	if len(b.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(b.Admin); err != nil {
+			return ErrInvalid.Wrapf("admin: %s", err)
+		}
+	}
+	return nil
 }
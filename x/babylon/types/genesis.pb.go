@@ -4,7 +4,10 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +30,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines babylon module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// hook_statuses are the block hook statuses of contracts with recorded
+	// failures
+	HookStatuses []GenesisHookStatus `protobuf:"bytes,2,rep,name=hook_statuses,json=hookStatuses,proto3" json:"hook_statuses"`
+	// contract_authorizations are the entries of the custom message
+	// authorization registry
+	ContractAuthorizations []ContractAuthorization `protobuf:"bytes,3,rep,name=contract_authorizations,json=contractAuthorizations,proto3" json:"contract_authorizations"`
+	// staking_msg_policy controls which contracts may dispatch staking messages
+	StakingMsgPolicy StakingMsgPolicy `protobuf:"bytes,4,opt,name=staking_msg_policy,json=stakingMsgPolicy,proto3" json:"staking_msg_policy"`
+	// scheduled_tasks is the work that contracts scheduled for future blocks
+	ScheduledTasks []GenesisScheduledTask `protobuf:"bytes,5,rep,name=scheduled_tasks,json=scheduledTasks,proto3" json:"scheduled_tasks"`
+	// indexed_headers are the recent block headers kept for contract queries
+	IndexedHeaders []IndexedHeader `protobuf:"bytes,6,rep,name=indexed_headers,json=indexedHeaders,proto3" json:"indexed_headers"`
+	// validator_set is the consumer validator set as of the last validator set
+	// update that was sent to the BTC staking contract
+	ValidatorSet []ConsumerValidator `protobuf:"bytes,7,rep,name=validator_set,json=validatorSet,proto3" json:"validator_set"`
+	// contracts_bootstrap optionally deploys the Babylon contracts at genesis.
+	// It is not exported, the deployed contracts are part of the params.
+	ContractsBootstrap *ContractsBootstrap `protobuf:"bytes,8,opt,name=contracts_bootstrap,json=contractsBootstrap,proto3" json:"contracts_bootstrap,omitempty"`
+	// code_pins are the code checksums that the Babylon contracts are pinned to
+	CodePins CodePins `protobuf:"bytes,9,opt,name=code_pins,json=codePins,proto3" json:"code_pins"`
+	// hook_registrations are the block hook subscriptions of the hook registry
+	HookRegistrations []HookRegistration `protobuf:"bytes,10,rep,name=hook_registrations,json=hookRegistrations,proto3" json:"hook_registrations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -62,8 +87,152 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// GenesisHookStatus is the block hook status of a contract in genesis
+type GenesisHookStatus struct {
+	// contract_address is the address of the contract
+	ContractAddress string     `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Status          HookStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+}
+
+func (m *GenesisHookStatus) Reset()         { *m = GenesisHookStatus{} }
+func (m *GenesisHookStatus) String() string { return proto.CompactTextString(m) }
+func (*GenesisHookStatus) ProtoMessage()    {}
+func (*GenesisHookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9588c8d0e398730c, []int{1}
+}
+func (m *GenesisHookStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisHookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisHookStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisHookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisHookStatus.Merge(m, src)
+}
+func (m *GenesisHookStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisHookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisHookStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisHookStatus proto.InternalMessageInfo
+
+// GenesisScheduledTask is the work that a contract scheduled in genesis
+type GenesisScheduledTask struct {
+	// contract_address is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// phase is the block phase of the execution, "begin_block" or "end_block"
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// height is the block height of the next execution
+	Height uint64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Work   ScheduledWork `protobuf:"bytes,4,opt,name=work,proto3" json:"work"`
+}
+
+func (m *GenesisScheduledTask) Reset()         { *m = GenesisScheduledTask{} }
+func (m *GenesisScheduledTask) String() string { return proto.CompactTextString(m) }
+func (*GenesisScheduledTask) ProtoMessage()    {}
+func (*GenesisScheduledTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9588c8d0e398730c, []int{2}
+}
+func (m *GenesisScheduledTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisScheduledTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisScheduledTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisScheduledTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisScheduledTask.Merge(m, src)
+}
+func (m *GenesisScheduledTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisScheduledTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisScheduledTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisScheduledTask proto.InternalMessageInfo
+
+// ContractsBootstrap defines the Babylon and BTC staking contracts that are
+// instantiated at genesis. Each contract is given either by its wasm byte code
+// or by the code ID of code stored in the wasm genesis.
+type ContractsBootstrap struct {
+	// babylon_contract_code is the wasm byte code of the Babylon contract
+	BabylonContractCode []byte `protobuf:"bytes,1,opt,name=babylon_contract_code,json=babylonContractCode,proto3" json:"babylon_contract_code,omitempty"`
+	// babylon_contract_code_id is the code ID of the Babylon contract
+	BabylonContractCodeId uint64 `protobuf:"varint,2,opt,name=babylon_contract_code_id,json=babylonContractCodeId,proto3" json:"babylon_contract_code_id,omitempty"`
+	// btc_staking_contract_code is the wasm byte code of the BTC staking
+	// contract
+	BtcStakingContractCode []byte `protobuf:"bytes,3,opt,name=btc_staking_contract_code,json=btcStakingContractCode,proto3" json:"btc_staking_contract_code,omitempty"`
+	// btc_staking_contract_code_id is the code ID of the BTC staking contract
+	BtcStakingContractCodeId uint64 `protobuf:"varint,4,opt,name=btc_staking_contract_code_id,json=btcStakingContractCodeId,proto3" json:"btc_staking_contract_code_id,omitempty"`
+	// babylon_init_msg is the JSON encoded instantiate message of the Babylon
+	// contract. The btc_staking_code_id and btc_staking_msg fields are set by
+	// the module.
+	BabylonInitMsg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=babylon_init_msg,json=babylonInitMsg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"babylon_init_msg,omitempty"`
+	// btc_staking_init_msg is the JSON encoded instantiate message of the BTC
+	// staking contract
+	BtcStakingInitMsg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,6,opt,name=btc_staking_init_msg,json=btcStakingInitMsg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"btc_staking_init_msg,omitempty"`
+	// admin is the optional admin of the Babylon contract
+	Admin string `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *ContractsBootstrap) Reset()         { *m = ContractsBootstrap{} }
+func (m *ContractsBootstrap) String() string { return proto.CompactTextString(m) }
+func (*ContractsBootstrap) ProtoMessage()    {}
+func (*ContractsBootstrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9588c8d0e398730c, []int{3}
+}
+func (m *ContractsBootstrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractsBootstrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractsBootstrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractsBootstrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractsBootstrap.Merge(m, src)
+}
+func (m *ContractsBootstrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractsBootstrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractsBootstrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractsBootstrap proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylonchain.babylon.v1beta1.GenesisState")
+	proto.RegisterType((*GenesisHookStatus)(nil), "babylonchain.babylon.v1beta1.GenesisHookStatus")
+	proto.RegisterType((*GenesisScheduledTask)(nil), "babylonchain.babylon.v1beta1.GenesisScheduledTask")
+	proto.RegisterType((*ContractsBootstrap)(nil), "babylonchain.babylon.v1beta1.ContractsBootstrap")
 }
 
 func init() {
@@ -71,22 +240,62 @@ func init() {
 }
 
 var fileDescriptor_9588c8d0e398730c = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x72, 0xf4, 0xcb, 0x0c, 0x93, 0x52,
-	0x4b, 0x12, 0x0d, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x64, 0x90, 0xd5, 0xea, 0x41, 0x39, 0x7a, 0x50, 0xb5, 0x52, 0xf8, 0x4d, 0x82, 0xa9,
-	0x06, 0x9b, 0x24, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0xc1,
-	0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0x8a, 0xe5, 0xe2, 0x71, 0x87, 0xb8,
-	0x21, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9d, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x45, 0x0f, 0x9f, 0x9b, 0xf4, 0x02, 0xc0, 0x6a, 0x9d,
-	0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0xbb, 0x15, 0xcb,
-	0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0xa1, 0x27, 0x1e, 0xca, 0x31, 0xac, 0x78, 0x24, 0xc7, 0x70, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xc6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd8, 0x3c, 0xa8, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x01, 0xf7, 0x6e,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xf1, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0xe3, 0xb4, 0x9a, 0x5d, 0x01, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x77, 0x9d, 0x4d, 0x77, 0x1a, 0x9a, 0x64, 0xba, 0x0d, 0xd3, 0xaa, 0x72, 0xa2, 0x0a,
+	0xa1, 0xa8, 0x50, 0x9b, 0x26, 0x07, 0x54, 0x24, 0x90, 0xba, 0x11, 0xb4, 0x39, 0x04, 0x45, 0x5e,
+	0xa0, 0x52, 0x2f, 0xd6, 0xd8, 0x33, 0xb2, 0x47, 0x1b, 0xcf, 0xac, 0xfc, 0x66, 0x9b, 0x84, 0xbf,
+	0xc0, 0x85, 0x9f, 0xc0, 0x09, 0xf5, 0xc8, 0x81, 0x1f, 0x11, 0x6e, 0x15, 0x07, 0xc4, 0xa9, 0x82,
+	0xe4, 0x00, 0xbf, 0x81, 0x13, 0xf2, 0x78, 0xbc, 0x75, 0x9a, 0xed, 0x6e, 0x0e, 0xbd, 0x24, 0xfb,
+	0xde, 0xbc, 0xef, 0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x18, 0xdd, 0x8b, 0x69, 0x7c, 0x7c, 0xa0, 0x64,
+	0x92, 0x51, 0x21, 0x03, 0x6b, 0x04, 0xcf, 0x1f, 0xc4, 0x5c, 0xd3, 0x07, 0x41, 0xca, 0x25, 0x07,
+	0x01, 0xfe, 0xa8, 0x50, 0x5a, 0xe1, 0x3b, 0xcd, 0x58, 0xdf, 0x1a, 0xbe, 0x8d, 0xbd, 0x3d, 0x3b,
+	0x53, 0x1d, 0x6d, 0x32, 0xdd, 0xfe, 0x78, 0x66, 0x2c, 0x24, 0x19, 0x67, 0xe3, 0x03, 0x5e, 0xd8,
+	0xe8, 0x5b, 0x89, 0x82, 0x5c, 0x41, 0x64, 0xac, 0xa0, 0x32, 0xec, 0x51, 0x2f, 0x55, 0xa9, 0xaa,
+	0xfc, 0xe5, 0x2f, 0xeb, 0x5d, 0xa5, 0xb9, 0x90, 0x2a, 0x30, 0x7f, 0x2b, 0xd7, 0xdd, 0xdf, 0x16,
+	0xd1, 0xd2, 0xe3, 0xaa, 0x9b, 0x81, 0xa6, 0x9a, 0xe3, 0xc7, 0xa8, 0x33, 0xa2, 0x05, 0xcd, 0x81,
+	0x38, 0x1b, 0xce, 0xe6, 0xb5, 0xad, 0x0f, 0xfc, 0x59, 0xdd, 0xf9, 0xfb, 0x26, 0xb6, 0xdf, 0x3d,
+	0x79, 0xb5, 0xde, 0x7a, 0xf1, 0xcf, 0x2f, 0xf7, 0x9c, 0xd0, 0xc2, 0xf1, 0x33, 0xf4, 0x5e, 0xa6,
+	0xd4, 0x30, 0x02, 0x4d, 0xf5, 0x18, 0x38, 0x90, 0x2b, 0x1b, 0xed, 0xcd, 0x6b, 0x5b, 0xc1, 0xec,
+	0x7c, 0xb6, 0x96, 0x27, 0x4a, 0x0d, 0x07, 0x06, 0xd8, 0x77, 0xcb, 0xd4, 0xe1, 0x52, 0x36, 0xf1,
+	0x70, 0xc0, 0x05, 0x7a, 0x3f, 0x51, 0x52, 0x17, 0x34, 0xd1, 0x11, 0x1d, 0xeb, 0x4c, 0x15, 0xe2,
+	0x7b, 0xaa, 0x85, 0x92, 0x40, 0xda, 0x86, 0x65, 0x7b, 0x36, 0xcb, 0x8e, 0x05, 0x3f, 0x6a, 0x62,
+	0x2d, 0xd3, 0x5a, 0x32, 0xed, 0x10, 0x70, 0x8a, 0x30, 0x68, 0x3a, 0x14, 0x32, 0x8d, 0x72, 0x48,
+	0xa3, 0x91, 0x3a, 0x10, 0xc9, 0x31, 0x71, 0xcd, 0x25, 0xf9, 0xb3, 0xe9, 0x06, 0x15, 0x6e, 0x0f,
+	0xd2, 0x7d, 0x83, 0x6a, 0x5e, 0xd7, 0x0a, 0xbc, 0x71, 0x88, 0x29, 0x5a, 0xae, 0x27, 0xcd, 0x22,
+	0x4d, 0x61, 0x08, 0x64, 0xc1, 0x34, 0xb5, 0x75, 0xa9, 0xab, 0x1b, 0xd4, 0xd8, 0x6f, 0x28, 0x0c,
+	0x6d, 0x4f, 0xd7, 0xa1, 0xe9, 0x2c, 0x67, 0xb3, 0x2c, 0x24, 0xe3, 0x47, 0x9c, 0x45, 0x19, 0xa7,
+	0x8c, 0x17, 0x40, 0x3a, 0x86, 0xe2, 0xa3, 0xd9, 0x14, 0xbb, 0x15, 0xe8, 0x89, 0xc1, 0xd4, 0xb9,
+	0x45, 0xd3, 0x69, 0xe6, 0xfe, 0x9c, 0x1e, 0x08, 0x46, 0xb5, 0x2a, 0x22, 0xe0, 0x9a, 0x2c, 0x5e,
+	0x66, 0xee, 0x3b, 0x4a, 0xc2, 0x38, 0xe7, 0xc5, 0x77, 0x35, 0xb4, 0x9e, 0xfb, 0x24, 0xd7, 0x80,
+	0x6b, 0x4c, 0xd1, 0x8d, 0x7a, 0x3a, 0x10, 0xc5, 0x4a, 0x69, 0xd0, 0x05, 0x1d, 0x91, 0xab, 0x66,
+	0x08, 0x9f, 0x5c, 0x6e, 0xe6, 0xd0, 0xaf, 0x71, 0x21, 0x4e, 0x2e, 0xf8, 0xf0, 0xd7, 0xa8, 0x9b,
+	0x28, 0xc6, 0xa3, 0x91, 0x90, 0x40, 0xba, 0x26, 0xf1, 0x87, 0xf3, 0x12, 0x33, 0xbe, 0x2f, 0xe4,
+	0x39, 0x11, 0x5c, 0x4d, 0xac, 0x13, 0x27, 0x08, 0x1b, 0x19, 0x14, 0x3c, 0x15, 0x25, 0x43, 0xb5,
+	0xa5, 0x68, 0xa3, 0x3d, 0x7f, 0x6d, 0x4a, 0x11, 0x84, 0x0d, 0x98, 0xbd, 0x92, 0xd5, 0xec, 0x0d,
+	0x3f, 0x7c, 0xe6, 0xfe, 0xfb, 0xd3, 0xba, 0x73, 0xf7, 0x67, 0x07, 0xad, 0x5e, 0xd0, 0x0f, 0xde,
+	0x41, 0x2b, 0xaf, 0xb5, 0xc2, 0x58, 0xc1, 0xa1, 0x92, 0x76, 0xb7, 0x4f, 0x7e, 0xff, 0xf5, 0x7e,
+	0xcf, 0x3e, 0x1b, 0x8f, 0xaa, 0x93, 0x81, 0x2e, 0x84, 0x4c, 0xc3, 0xe5, 0x89, 0x06, 0x2a, 0x37,
+	0xfe, 0x0a, 0x75, 0x2a, 0x1d, 0x93, 0x2b, 0xe6, 0x4a, 0x36, 0xe7, 0x57, 0x7e, 0x4e, 0xbe, 0x16,
+	0x6d, 0x0b, 0xfd, 0xc3, 0x41, 0xbd, 0x69, 0xdb, 0xfa, 0x6e, 0x6a, 0xed, 0xa1, 0x85, 0x51, 0x46,
+	0x81, 0x9b, 0x52, 0xbb, 0x61, 0x65, 0xe0, 0x35, 0xd4, 0xc9, 0xb8, 0x48, 0x33, 0x4d, 0xda, 0x1b,
+	0xce, 0xa6, 0x1b, 0x5a, 0x0b, 0x7f, 0x89, 0xdc, 0x43, 0x55, 0x0c, 0xad, 0x90, 0xe7, 0xec, 0xff,
+	0xa4, 0xda, 0xa7, 0xaa, 0xa8, 0xb5, 0x65, 0xe0, 0xb6, 0xb1, 0x1f, 0x5c, 0x84, 0x2f, 0xee, 0x19,
+	0xde, 0x42, 0x37, 0x6d, 0xa6, 0x68, 0xd2, 0x5e, 0xb9, 0x20, 0xa6, 0xb7, 0xa5, 0xf0, 0x86, 0x3d,
+	0xac, 0x91, 0xe5, 0x42, 0xe1, 0x4f, 0x11, 0x99, 0x8a, 0x89, 0x04, 0x33, 0x8d, 0xb9, 0xe1, 0xcd,
+	0x29, 0xb0, 0x5d, 0x86, 0x1f, 0xa2, 0x5b, 0xb1, 0x4e, 0xa2, 0xfa, 0xad, 0x3a, 0x4f, 0xd8, 0x36,
+	0x84, 0x6b, 0xb1, 0x4e, 0xec, 0x9b, 0x74, 0x8e, 0xf3, 0x0b, 0x74, 0xe7, 0xad, 0xd0, 0x92, 0xd7,
+	0x35, 0xbc, 0x64, 0x3a, 0x7a, 0x97, 0xe1, 0x14, 0xad, 0xd4, 0x35, 0x0b, 0x29, 0x74, 0xf9, 0x4e,
+	0x92, 0x85, 0x92, 0xb1, 0xff, 0xf9, 0x7f, 0xaf, 0xd6, 0x1f, 0xa6, 0x42, 0x67, 0xe3, 0xd8, 0x4f,
+	0x54, 0x1e, 0xec, 0x28, 0xc8, 0x9f, 0x52, 0xc8, 0x83, 0x43, 0x0a, 0x39, 0x0b, 0x8e, 0xcc, 0xff,
+	0x40, 0x1f, 0x8f, 0x38, 0xf8, 0x21, 0x3d, 0xac, 0xf3, 0xee, 0x71, 0x00, 0x9a, 0xf2, 0xf0, 0xba,
+	0x4d, 0xbb, 0x2b, 0x85, 0xde, 0x83, 0x14, 0x4b, 0xd4, 0x6b, 0x16, 0x3a, 0x21, 0xeb, 0xbc, 0x0b,
+	0xb2, 0xd5, 0xd7, 0xfd, 0xd5, 0x7c, 0x3e, 0x5a, 0xa0, 0x2c, 0x17, 0x92, 0x2c, 0xce, 0x59, 0xc6,
+	0x2a, 0xac, 0xda, 0x86, 0xfe, 0xb7, 0x27, 0x7f, 0x7b, 0xad, 0x17, 0xa7, 0x5e, 0xeb, 0xe4, 0xd4,
+	0x73, 0x5e, 0x9e, 0x7a, 0xce, 0x5f, 0xa7, 0x9e, 0xf3, 0xe3, 0x99, 0xd7, 0x7a, 0x79, 0xe6, 0xb5,
+	0xfe, 0x3c, 0xf3, 0x5a, 0xcf, 0xb6, 0x1b, 0x55, 0x4e, 0xfb, 0xf4, 0xdf, 0x07, 0x36, 0x0c, 0x8e,
+	0x6a, 0xab, 0x2a, 0x39, 0xee, 0x98, 0x2f, 0xf7, 0xf6, 0xff, 0x03, 0x00, 0x38, 0xaa, 0x44, 0x31,
+	0xa3, 0x08, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -111,6 +320,165 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
+	if len(this.HookStatuses) != len(that1.HookStatuses) {
+		return false
+	}
+	for i := range this.HookStatuses {
+		if !this.HookStatuses[i].Equal(&that1.HookStatuses[i]) {
+			return false
+		}
+	}
+	if len(this.ContractAuthorizations) != len(that1.ContractAuthorizations) {
+		return false
+	}
+	for i := range this.ContractAuthorizations {
+		if !this.ContractAuthorizations[i].Equal(&that1.ContractAuthorizations[i]) {
+			return false
+		}
+	}
+	if !this.StakingMsgPolicy.Equal(&that1.StakingMsgPolicy) {
+		return false
+	}
+	if len(this.ScheduledTasks) != len(that1.ScheduledTasks) {
+		return false
+	}
+	for i := range this.ScheduledTasks {
+		if !this.ScheduledTasks[i].Equal(&that1.ScheduledTasks[i]) {
+			return false
+		}
+	}
+	if len(this.IndexedHeaders) != len(that1.IndexedHeaders) {
+		return false
+	}
+	for i := range this.IndexedHeaders {
+		if !this.IndexedHeaders[i].Equal(&that1.IndexedHeaders[i]) {
+			return false
+		}
+	}
+	if len(this.ValidatorSet) != len(that1.ValidatorSet) {
+		return false
+	}
+	for i := range this.ValidatorSet {
+		if !this.ValidatorSet[i].Equal(&that1.ValidatorSet[i]) {
+			return false
+		}
+	}
+	if !this.ContractsBootstrap.Equal(that1.ContractsBootstrap) {
+		return false
+	}
+	if !this.CodePins.Equal(&that1.CodePins) {
+		return false
+	}
+	if len(this.HookRegistrations) != len(that1.HookRegistrations) {
+		return false
+	}
+	for i := range this.HookRegistrations {
+		if !this.HookRegistrations[i].Equal(&that1.HookRegistrations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GenesisHookStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenesisHookStatus)
+	if !ok {
+		that2, ok := that.(GenesisHookStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	return true
+}
+func (this *GenesisScheduledTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenesisScheduledTask)
+	if !ok {
+		that2, ok := that.(GenesisScheduledTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Phase != that1.Phase {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Work.Equal(&that1.Work) {
+		return false
+	}
+	return true
+}
+func (this *ContractsBootstrap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractsBootstrap)
+	if !ok {
+		that2, ok := that.(ContractsBootstrap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.BabylonContractCode, that1.BabylonContractCode) {
+		return false
+	}
+	if this.BabylonContractCodeId != that1.BabylonContractCodeId {
+		return false
+	}
+	if !bytes.Equal(this.BtcStakingContractCode, that1.BtcStakingContractCode) {
+		return false
+	}
+	if this.BtcStakingContractCodeId != that1.BtcStakingContractCodeId {
+		return false
+	}
+	if !bytes.Equal(this.BabylonInitMsg, that1.BabylonInitMsg) {
+		return false
+	}
+	if !bytes.Equal(this.BtcStakingInitMsg, that1.BtcStakingInitMsg) {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +501,122 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HookRegistrations) > 0 {
+		for iNdEx := len(m.HookRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.CodePins.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ContractsBootstrap != nil {
+		{
+			size, err := m.ContractsBootstrap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IndexedHeaders) > 0 {
+		for iNdEx := len(m.IndexedHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexedHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ScheduledTasks) > 0 {
+		for iNdEx := len(m.ScheduledTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.StakingMsgPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ContractAuthorizations) > 0 {
+		for iNdEx := len(m.ContractAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HookStatuses) > 0 {
+		for iNdEx := len(m.HookStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,35 +630,311 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisHookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GenesisHookStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+
+func (m *GenesisHookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisScheduledTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisScheduledTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisScheduledTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Work.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractsBootstrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractsBootstrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractsBootstrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BtcStakingInitMsg) > 0 {
+		i -= len(m.BtcStakingInitMsg)
+		copy(dAtA[i:], m.BtcStakingInitMsg)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BtcStakingInitMsg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BabylonInitMsg) > 0 {
+		i -= len(m.BabylonInitMsg)
+		copy(dAtA[i:], m.BabylonInitMsg)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BabylonInitMsg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcStakingContractCodeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BtcStakingContractCodeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BtcStakingContractCode) > 0 {
+		i -= len(m.BtcStakingContractCode)
+		copy(dAtA[i:], m.BtcStakingContractCode)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BtcStakingContractCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BabylonContractCodeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BabylonContractCodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BabylonContractCode) > 0 {
+		i -= len(m.BabylonContractCode)
+		copy(dAtA[i:], m.BabylonContractCode)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BabylonContractCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HookStatuses) > 0 {
+		for _, e := range m.HookStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractAuthorizations) > 0 {
+		for _, e := range m.ContractAuthorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.StakingMsgPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledTasks) > 0 {
+		for _, e := range m.ScheduledTasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IndexedHeaders) > 0 {
+		for _, e := range m.IndexedHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractsBootstrap != nil {
+		l = m.ContractsBootstrap.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CodePins.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HookRegistrations) > 0 {
+		for _, e := range m.HookRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisHookStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisScheduledTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Work.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ContractsBootstrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BabylonContractCode)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BabylonContractCodeId != 0 {
+		n += 1 + sovGenesis(uint64(m.BabylonContractCodeId))
+	}
+	l = len(m.BtcStakingContractCode)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BtcStakingContractCodeId != 0 {
+		n += 1 + sovGenesis(uint64(m.BtcStakingContractCodeId))
+	}
+	l = len(m.BabylonInitMsg)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BtcStakingInitMsg)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -236,6 +996,849 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookStatuses = append(m.HookStatuses, GenesisHookStatus{})
+			if err := m.HookStatuses[len(m.HookStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAuthorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAuthorizations = append(m.ContractAuthorizations, ContractAuthorization{})
+			if err := m.ContractAuthorizations[len(m.ContractAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingMsgPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingMsgPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTasks = append(m.ScheduledTasks, GenesisScheduledTask{})
+			if err := m.ScheduledTasks[len(m.ScheduledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedHeaders = append(m.IndexedHeaders, IndexedHeader{})
+			if err := m.IndexedHeaders[len(m.IndexedHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, ConsumerValidator{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsBootstrap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractsBootstrap == nil {
+				m.ContractsBootstrap = &ContractsBootstrap{}
+			}
+			if err := m.ContractsBootstrap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodePins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodePins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookRegistrations = append(m.HookRegistrations, HookRegistration{})
+			if err := m.HookRegistrations[len(m.HookRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisHookStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisHookStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisHookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisScheduledTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisScheduledTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisScheduledTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractsBootstrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractsBootstrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractsBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonContractCode = append(m.BabylonContractCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonContractCode == nil {
+				m.BabylonContractCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractCodeId", wireType)
+			}
+			m.BabylonContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingContractCode = append(m.BtcStakingContractCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcStakingContractCode == nil {
+				m.BtcStakingContractCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractCodeId", wireType)
+			}
+			m.BtcStakingContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcStakingContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonInitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonInitMsg = append(m.BabylonInitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonInitMsg == nil {
+				m.BabylonInitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingInitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingInitMsg = append(m.BtcStakingInitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcStakingInitMsg == nil {
+				m.BtcStakingInitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
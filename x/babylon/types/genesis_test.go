@@ -4,11 +4,15 @@ import (
 	"testing"
 
 	"github.com/babylonchain/babylon-sdk/x/babylon/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	myContractAddr := sdk.AccAddress(make([]byte, 32)).String()
 	specs := map[string]struct {
 		state  types.GenesisState
 		expErr bool
@@ -20,7 +24,9 @@ func TestValidateGenesis(t *testing.T) {
 		"custom param, should pass": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 600_000,
+					MaxGasBeginBlocker:    600_000,
+					MaxGasEndBlocker:      600_000,
+					HeaderRetentionBlocks: 100,
 				},
 			},
 			expErr: false,
@@ -28,7 +34,9 @@ func TestValidateGenesis(t *testing.T) {
 		"custom small value param, should pass": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 10_000,
+					MaxGasBeginBlocker:    10_000,
+					MaxGasEndBlocker:      10_000,
+					HeaderRetentionBlocks: 100,
 				},
 			},
 			expErr: false,
@@ -37,8 +45,263 @@ func TestValidateGenesis(t *testing.T) {
 			state: types.GenesisState{
 				Params: types.Params{
 					MaxGasBeginBlocker: 0,
+					MaxGasEndBlocker:   10_000,
+				},
+			},
+			expErr: true,
+		},
+		"invalid max gas end-blocker, should fail": {
+			state: types.GenesisState{
+				Params: types.Params{
+					MaxGasBeginBlocker: 10_000,
+					MaxGasEndBlocker:   0,
+				},
+			},
+			expErr: true,
+		},
+		"max block rewards without rewards denom, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.RewardsDenom = ""
+				gs.Params.MaxBlockRewards = 100
+				return gs
+			}(),
+			expErr: true,
+		},
+		"empty header retention blocks, should pass": {
+			state: types.GenesisState{
+				Params: types.Params{
+					MaxGasBeginBlocker: 10_000,
+					MaxGasEndBlocker:   10_000,
 				},
 			},
+			expErr: false,
+		},
+		"full state, should pass": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.HookStatuses = []types.GenesisHookStatus{{ContractAddress: myContractAddr, Status: types.HookStatus{ConsecutiveFailures: 1}}}
+				gs.ContractAuthorizations = []types.ContractAuthorization{{ContractAddress: myContractAddr, MsgTypes: []string{types.CustomMsgTypeMintRewards}}}
+				gs.StakingMsgPolicy = types.StakingMsgPolicy{AllowedContracts: []string{myContractAddr}}
+				gs.ScheduledTasks = []types.GenesisScheduledTask{{ContractAddress: myContractAddr, Phase: types.SudoHookEndBlock, Height: 1}}
+				gs.IndexedHeaders = []types.IndexedHeader{{Height: 1}}
+				return gs
+			}(),
+			expErr: false,
+		},
+		"duplicate hook status, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.HookStatuses = []types.GenesisHookStatus{{ContractAddress: myContractAddr}, {ContractAddress: myContractAddr}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"invalid contract authorization, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractAuthorizations = []types.ContractAuthorization{{ContractAddress: myContractAddr}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"invalid staking msg policy, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.StakingMsgPolicy = types.StakingMsgPolicy{AllowedContracts: []string{"invalid"}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"invalid scheduled task phase, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ScheduledTasks = []types.GenesisScheduledTask{{ContractAddress: myContractAddr, Phase: "unknown", Height: 1}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"duplicate scheduled task, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				task := types.GenesisScheduledTask{ContractAddress: myContractAddr, Phase: types.SudoHookBeginBlock, Height: 1}
+				gs.ScheduledTasks = []types.GenesisScheduledTask{task, task}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"duplicate indexed header, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.IndexedHeaders = []types.IndexedHeader{{Height: 1}, {Height: 1}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"hook registrations, should pass": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.HookRegistrations = []types.HookRegistration{
+					{ContractAddress: myContractAddr, Hook: types.SudoHookBeginBlock, Priority: 1},
+					{ContractAddress: myContractAddr, Hook: types.SudoHookEndBlock, GasLimit: 100_000},
+				}
+				return gs
+			}(),
+			expErr: false,
+		},
+		"invalid hook registration, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.HookRegistrations = []types.HookRegistration{{ContractAddress: myContractAddr, Hook: "unknown"}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"duplicate hook registration, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.HookRegistrations = []types.HookRegistration{
+					{ContractAddress: myContractAddr, Hook: types.SudoHookBeginBlock, Priority: 1},
+					{ContractAddress: myContractAddr, Hook: types.SudoHookBeginBlock, Priority: 2},
+				}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"invalid contract address in params, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.BtcStakingContractAddress = "invalid"
+				return gs
+			}(),
+			expErr: true,
+		},
+		"duplicate code pin, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				checksum := make([]byte, types.ChecksumLength)
+				gs.CodePins.BtcStakingContractChecksums = [][]byte{checksum, checksum}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"hook subscriptions, should pass": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.HookSubscriptions = []types.HookSubscription{
+					{Contract: types.ContractBabylon, EndBlock: true, GasLimit: 100_000},
+					{Contract: types.ContractBTCStaking, BeginBlock: true, EndBlock: true},
+				}
+				return gs
+			}(),
+			expErr: false,
+		},
+		"duplicate hook subscription, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				sub := types.HookSubscription{Contract: types.ContractBabylon, BeginBlock: true}
+				gs.Params.HookSubscriptions = []types.HookSubscription{sub, sub}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"hook subscription of unknown contract, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.HookSubscriptions = []types.HookSubscription{{Contract: "other", BeginBlock: true}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"hook subscription without hooks, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.HookSubscriptions = []types.HookSubscription{{Contract: types.ContractBabylon}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"validator set update subscription, should pass": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.HookSubscriptions = []types.HookSubscription{{Contract: types.ContractBTCStaking, ValsetUpdate: true}}
+				return gs
+			}(),
+			expErr: false,
+		},
+		"validator set update subscription of babylon contract, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.HookSubscriptions = []types.HookSubscription{{Contract: types.ContractBabylon, EndBlock: true, ValsetUpdate: true}}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"contracts bootstrap with code ids, should pass": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractsBootstrap = &types.ContractsBootstrap{
+					BabylonContractCodeId:    1,
+					BtcStakingContractCodeId: 2,
+					BabylonInitMsg:           []byte(`{"network":"regtest"}`),
+					BtcStakingInitMsg:        []byte(`{}`),
+					Admin:                    myContractAddr,
+				}
+				return gs
+			}(),
+			expErr: false,
+		},
+		"contracts bootstrap with code and code id, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractsBootstrap = &types.ContractsBootstrap{
+					BabylonContractCode:      []byte{0x0, 0x61, 0x73, 0x6d},
+					BabylonContractCodeId:    1,
+					BtcStakingContractCodeId: 2,
+				}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"contracts bootstrap without btc staking code, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractsBootstrap = &types.ContractsBootstrap{BabylonContractCodeId: 1}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"contracts bootstrap with non object init msg, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractsBootstrap = &types.ContractsBootstrap{
+					BabylonContractCodeId:    1,
+					BtcStakingContractCodeId: 2,
+					BabylonInitMsg:           []byte(`[]`),
+				}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"contracts bootstrap with invalid btc staking init msg, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.ContractsBootstrap = &types.ContractsBootstrap{
+					BabylonContractCodeId:    1,
+					BtcStakingContractCodeId: 2,
+					BtcStakingInitMsg:        []byte(`{"admin":`),
+				}
+				return gs
+			}(),
+			expErr: true,
+		},
+		"contracts bootstrap with contract addresses in params, should fail": {
+			state: func() types.GenesisState {
+				gs := *types.DefaultGenesisState(sdk.DefaultBondDenom)
+				gs.Params.BabylonContractAddress = myContractAddr
+				gs.ContractsBootstrap = &types.ContractsBootstrap{BabylonContractCodeId: 1, BtcStakingContractCodeId: 2}
+				return gs
+			}(),
 			expErr: true,
 		},
 		"invalid max cap coin denom, should fail": {
@@ -69,3 +332,25 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestContractsBootstrapJSON(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	gs := types.DefaultGenesisState(sdk.DefaultBondDenom)
+	gs.ContractsBootstrap = &types.ContractsBootstrap{
+		BabylonContractCodeId:    1,
+		BtcStakingContractCodeId: 2,
+		BabylonInitMsg:           []byte(`{"network":"regtest"}`),
+		BtcStakingInitMsg:        []byte(`{}`),
+	}
+
+	// when
+	bz, err := cdc.MarshalJSON(gs)
+	require.NoError(t, err)
+
+	// then the init messages are embedded as plain JSON
+	assert.Contains(t, string(bz), `"babylon_init_msg":{"network":"regtest"}`)
+	assert.Contains(t, string(bz), `"btc_staking_init_msg":{}`)
+	var got types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &got))
+	assert.Equal(t, gs.ContractsBootstrap, got.ContractsBootstrap)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation on a hook registration.
+func (r HookRegistration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return ErrInvalid.Wrapf("contract address: %s", err)
+	}
+	if _, err := SchedulerPhaseFromHook(r.Hook); err != nil {
+		return err
+	}
+	return nil
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "babylon"
@@ -7,9 +14,6 @@ const (
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
 
-	// MemStoreKey defines the in-memory store key
-	MemStoreKey = "memory:babylon"
-
 	// RouterKey is the message route
 	RouterKey = ModuleName
 
@@ -20,4 +24,77 @@ const (
 var (
 	// ParamsKey is the prefix for the module parameters
 	ParamsKey = []byte{0x1}
+
+	// HookStatusKeyPrefix is the prefix for the block hook status of a contract
+	HookStatusKeyPrefix = []byte{0x2}
+
+	// IndexedHeaderKeyPrefix is the prefix for the indexed block headers
+	IndexedHeaderKeyPrefix = []byte{0x3}
+
+	// ContractAuthorizationKeyPrefix is the prefix for the custom message authorizations of contracts
+	ContractAuthorizationKeyPrefix = []byte{0x4}
+
+	// StakingMsgPolicyKey is the key for the policy that controls which contracts may dispatch staking messages
+	StakingMsgPolicyKey = []byte{0x5}
+
+	// SchedulerKeyPrefix is the prefix for the work that contracts scheduled for future blocks
+	SchedulerKeyPrefix = []byte{0x6}
+
+	// ValidatorSetKeyPrefix is the prefix for the consumer validators of the last validator set update
+	ValidatorSetKeyPrefix = []byte{0x7}
+
+	// CodePinsKey is the key for the code checksums that the Babylon contracts are pinned to
+	CodePinsKey = []byte{0x8}
+
+	// HookRegistrationKeyPrefix is the prefix for the block hook subscriptions of the hook registry
+	HookRegistrationKeyPrefix = []byte{0x9}
+
+	// MintedRewardsKeyPrefix is the prefix for the block rewards that contracts minted in a block
+	MintedRewardsKeyPrefix = []byte{0xa}
 )
+
+// BuildHookStatusKey build store key for the block hook status of a contract
+func BuildHookStatusKey(contractAddr sdk.AccAddress) []byte {
+	return append(slices.Clone(HookStatusKeyPrefix), address.MustLengthPrefix(contractAddr)...)
+}
+
+// BuildIndexedHeaderKey build store key for the indexed header of the block at the given height
+func BuildIndexedHeaderKey(height int64) []byte {
+	return append(slices.Clone(IndexedHeaderKeyPrefix), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// BuildContractAuthorizationKey build store key for the custom message authorization of a contract
+func BuildContractAuthorizationKey(contractAddr sdk.AccAddress) []byte {
+	return append(slices.Clone(ContractAuthorizationKeyPrefix), address.MustLengthPrefix(contractAddr)...)
+}
+
+// BuildSchedulerPhasePrefix build store key prefix for all scheduled work of the given block phase
+func BuildSchedulerPhasePrefix(phase SchedulerPhase) []byte {
+	return append(slices.Clone(SchedulerKeyPrefix), byte(phase))
+}
+
+// BuildSchedulerKey build store key for the work that a contract scheduled at the given block phase and height
+func BuildSchedulerKey(phase SchedulerPhase, height uint64, contractAddr sdk.AccAddress) []byte {
+	key := append(BuildSchedulerPhasePrefix(phase), sdk.Uint64ToBigEndian(height)...)
+	return append(key, address.MustLengthPrefix(contractAddr)...)
+}
+
+// BuildValidatorSetKey build store key for a consumer validator of the last validator set update
+func BuildValidatorSetKey(valAddr sdk.ValAddress) []byte {
+	return append(slices.Clone(ValidatorSetKeyPrefix), address.MustLengthPrefix(valAddr)...)
+}
+
+// BuildMintedRewardsKey build store key for the block rewards minted at the given height
+func BuildMintedRewardsKey(height uint64) []byte {
+	return append(slices.Clone(MintedRewardsKeyPrefix), sdk.Uint64ToBigEndian(height)...)
+}
+
+// BuildHookRegistrationPhasePrefix build store key prefix for all hook registrations of the given block phase
+func BuildHookRegistrationPhasePrefix(phase SchedulerPhase) []byte {
+	return append(slices.Clone(HookRegistrationKeyPrefix), byte(phase))
+}
+
+// BuildHookRegistrationKey build store key for the hook registration of a contract at the given block phase
+func BuildHookRegistrationKey(phase SchedulerPhase, contractAddr sdk.AccAddress) []byte {
+	return append(BuildHookRegistrationPhasePrefix(phase), address.MustLengthPrefix(contractAddr)...)
+}
@@ -1,16 +1,143 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChecksumLength is the length of a wasm code checksum
+const ChecksumLength = 32
+
+// DefaultHeaderRetentionBlocks is the default number of recent block headers that are kept indexed.
+// The indexed headers are exported with the genesis state.
+const DefaultHeaderRetentionBlocks = 10_000
+
+// DefaultMaxScheduledTasksPerBlock is the default number of scheduled tasks executed at each block phase
+const DefaultMaxScheduledTasksPerBlock = 100
+
+const (
+	// ContractBabylon identifies the Babylon contract configured in the params
+	ContractBabylon = "babylon"
+	// ContractBTCStaking identifies the BTC staking contract configured in the params
+	ContractBTCStaking = "btc_staking"
+)
+
 // DefaultParams returns default babylon parameters
 func DefaultParams(denom string) Params {
 	return Params{
-		MaxGasBeginBlocker: 500_000,
+		MaxGasBeginBlocker:        500_000,
+		MaxGasEndBlocker:          500_000,
+		HeaderRetentionBlocks:     DefaultHeaderRetentionBlocks,
+		RewardsDenom:              denom,
+		MaxScheduledTasksPerBlock: DefaultMaxScheduledTasksPerBlock,
 	}
 }
 
 // ValidateBasic performs basic validation on babylon parameters.
 func (p Params) ValidateBasic() error {
 	if p.MaxGasBeginBlocker == 0 {
+		return ErrInvalid.Wrap("empty max gas begin-blocker setting")
+	}
+	if p.MaxGasEndBlocker == 0 {
 		return ErrInvalid.Wrap("empty max gas end-blocker setting")
 	}
+	if p.MaxBlockRewards != 0 {
+		if err := sdk.ValidateDenom(p.RewardsDenom); err != nil {
+			return ErrInvalid.Wrapf("rewards denom: %s", err)
+		}
+	}
+	if len(p.BabylonContractAddress) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.BabylonContractAddress); err != nil {
+			return ErrInvalid.Wrapf("babylon contract address: %s", err)
+		}
+	}
+	if len(p.BtcStakingContractAddress) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.BtcStakingContractAddress); err != nil {
+			return ErrInvalid.Wrapf("btc staking contract address: %s", err)
+		}
+	}
+	seen := make(map[string]struct{}, len(p.HookSubscriptions))
+	for _, sub := range p.HookSubscriptions {
+		if err := sub.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := seen[sub.Contract]; ok {
+			return ErrInvalid.Wrapf("duplicate hook subscription: %s", sub.Contract)
+		}
+		seen[sub.Contract] = struct{}{}
+	}
+	return nil
+}
+
+// ContractAddress returns the address of the configured contract with the given name
+func (p Params) ContractAddress(contract string) string {
+	switch contract {
+	case ContractBabylon:
+		return p.BabylonContractAddress
+	case ContractBTCStaking:
+		return p.BtcStakingContractAddress
+	default:
+		return ""
+	}
+}
+
+// GetHookSubscriptions returns the hook subscriptions or, when none are set, the subscription of the
+// BTC staking contract to both block hooks
+func (p Params) GetHookSubscriptions() []HookSubscription {
+	if len(p.HookSubscriptions) != 0 {
+		return p.HookSubscriptions
+	}
+	return []HookSubscription{{Contract: ContractBTCStaking, BeginBlock: true, EndBlock: true}}
+}
+
+// GetHeaderRetentionBlocks returns the header retention or, when it is not set, the DefaultHeaderRetentionBlocks
+func (p Params) GetHeaderRetentionBlocks() uint32 {
+	if p.HeaderRetentionBlocks != 0 {
+		return p.HeaderRetentionBlocks
+	}
+	return DefaultHeaderRetentionBlocks
+}
+
+// GetMaxScheduledTasksPerBlock returns the scheduled task limit or, when it is not set, the
+// DefaultMaxScheduledTasksPerBlock
+func (p Params) GetMaxScheduledTasksPerBlock() uint32 {
+	if p.MaxScheduledTasksPerBlock != 0 {
+		return p.MaxScheduledTasksPerBlock
+	}
+	return DefaultMaxScheduledTasksPerBlock
+}
+
+// MaxSudoGas returns the gas limit for the contract sudo callback of the given block hook
+func (p Params) MaxSudoGas(hook string) uint64 {
+	if hook == SudoHookEndBlock {
+		return uint64(p.MaxGasEndBlocker)
+	}
+	return uint64(p.MaxGasBeginBlocker)
+}
+
+// ValidateBasic performs basic validation on a hook subscription.
+func (s HookSubscription) ValidateBasic() error {
+	if s.Contract != ContractBabylon && s.Contract != ContractBTCStaking {
+		return ErrInvalid.Wrapf("hook subscription contract: %q", s.Contract)
+	}
+	if !s.BeginBlock && !s.EndBlock && !s.ValsetUpdate {
+		return ErrInvalid.Wrapf("hook subscription without hooks: %s", s.Contract)
+	}
+	if s.ValsetUpdate && s.Contract != ContractBTCStaking {
+		return ErrInvalid.Wrapf("validator set update subscription of contract: %s", s.Contract)
+	}
 	return nil
 }
+
+// Subscribes returns true when the subscription includes the block hook or the validator set update
+func (s HookSubscription) Subscribes(hook string) bool {
+	switch hook {
+	case SudoHookBeginBlock:
+		return s.BeginBlock
+	case SudoHookEndBlock:
+		return s.EndBlock
+	case SudoHookValsetUpdate:
+		return s.ValsetUpdate
+	default:
+		return false
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+// ValidateBasic performs basic validation on scheduled work.
+func (w ScheduledWork) ValidateBasic() error {
+	if w.Repeat && w.Interval == 0 {
+		return ErrInvalid.Wrap("empty interval for repeated work")
+	}
+	if !w.Repeat && w.Interval != 0 {
+		return ErrInvalid.Wrap("interval set for one-shot work")
+	}
+	return nil
+}
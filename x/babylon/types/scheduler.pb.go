@@ -4,22 +4,20 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ = proto.Marshal
-	_ = fmt.Errorf
-	_ = math.Inf
-)
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,9 +25,16 @@ var (
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// ScheduledWork is XX
+// ScheduledWork is a sudo callback that a contract registered for execution
+// at a future block height
 type ScheduledWork struct {
+	// repeat defines whether the work is rescheduled after a successful
+	// execution
 	Repeat bool `protobuf:"varint,1,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	// interval is the number of blocks between two executions of repeated work
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// payload is opaque data that is passed back to the contract on execution
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
 func (m *ScheduledWork) Reset()         { *m = ScheduledWork{} }
@@ -38,11 +43,9 @@ func (*ScheduledWork) ProtoMessage()    {}
 func (*ScheduledWork) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cdcaeea24db3b88c, []int{0}
 }
-
 func (m *ScheduledWork) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *ScheduledWork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_ScheduledWork.Marshal(b, m, deterministic)
@@ -55,15 +58,12 @@ func (m *ScheduledWork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-
 func (m *ScheduledWork) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ScheduledWork.Merge(m, src)
 }
-
 func (m *ScheduledWork) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *ScheduledWork) XXX_DiscardUnknown() {
 	xxx_messageInfo_ScheduledWork.DiscardUnknown(m)
 }
@@ -82,11 +82,9 @@ func (*ValidatorAddress) ProtoMessage()    {}
 func (*ValidatorAddress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cdcaeea24db3b88c, []int{1}
 }
-
 func (m *ValidatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-
 func (m *ValidatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_ValidatorAddress.Marshal(b, m, deterministic)
@@ -99,15 +97,12 @@ func (m *ValidatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-
 func (m *ValidatorAddress) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ValidatorAddress.Merge(m, src)
 }
-
 func (m *ValidatorAddress) XXX_Size() int {
 	return m.Size()
 }
-
 func (m *ValidatorAddress) XXX_DiscardUnknown() {
 	xxx_messageInfo_ValidatorAddress.DiscardUnknown(m)
 }
@@ -124,25 +119,58 @@ func init() {
 }
 
 var fileDescriptor_cdcaeea24db3b88c = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x72, 0xf4, 0xcb, 0x0c, 0x93, 0x52,
-	0x4b, 0x12, 0x0d, 0xf5, 0x8b, 0x93, 0x33, 0x52, 0x53, 0x4a, 0x73, 0x52, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x90, 0x55, 0xeb, 0x41, 0x39, 0x7a, 0x50, 0xd5, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x85, 0xfa, 0x20, 0x16, 0x44, 0x8f, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e,
-	0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xa9, 0x73, 0xf1, 0x06, 0x43, 0x6d, 0x48,
-	0x09, 0xcf, 0x2f, 0xca, 0x16, 0x12, 0xe3, 0x62, 0x2b, 0x4a, 0x2d, 0x48, 0x4d, 0x2c, 0x91, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x08, 0x82, 0xf2, 0x94, 0xdc, 0xb8, 0x04, 0xc2, 0x12, 0x73, 0x32, 0x53,
-	0x12, 0x4b, 0xf2, 0x8b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x85, 0x8c, 0xb8, 0xd8, 0x13,
-	0x21, 0x4c, 0xb0, 0x62, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0xe6, 0x43, 0x15, 0x05,
-	0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0xc1, 0x14, 0x3a, 0x85, 0x9e, 0x78, 0x28, 0xc7, 0xb0, 0xe2,
-	0x91, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x63, 0x0b, 0x1a, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x78, 0x40, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x63, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x7c, 0x99, 0x48, 0x4d, 0x01, 0x00, 0x00,
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x33, 0x5a, 0xda, 0x3a, 0x28, 0x48, 0x10, 0x89, 0x41, 0xc7, 0xd8, 0x55, 0x16, 0x36,
+	0x43, 0xe9, 0x4e, 0x57, 0xf6, 0x02, 0x42, 0x8a, 0x0a, 0x6e, 0x64, 0x92, 0x19, 0x92, 0xd0, 0x34,
+	0x13, 0x66, 0xa6, 0xc5, 0xdc, 0xc2, 0x23, 0xb8, 0xf4, 0x00, 0x1e, 0xa2, 0xcb, 0xe2, 0xca, 0xa5,
+	0x26, 0x1b, 0x8f, 0x21, 0x26, 0x13, 0x11, 0xe9, 0xee, 0x7d, 0x8f, 0xef, 0xf1, 0x3f, 0x7e, 0x78,
+	0x1e, 0x90, 0xa0, 0x48, 0x79, 0x16, 0xc6, 0x24, 0xc9, 0xb0, 0x06, 0xbc, 0x1c, 0x05, 0x4c, 0x91,
+	0x11, 0x96, 0x61, 0xcc, 0xe8, 0x22, 0x65, 0xc2, 0xcb, 0x05, 0x57, 0xdc, 0x3c, 0xfe, 0x6b, 0x7b,
+	0x1a, 0x3c, 0x6d, 0xdb, 0x47, 0x21, 0x97, 0x73, 0x2e, 0x1f, 0x6a, 0x17, 0x37, 0xd0, 0x1c, 0xda,
+	0x07, 0x11, 0x8f, 0x78, 0xb3, 0xff, 0x99, 0x9a, 0xed, 0x20, 0x84, 0x7b, 0x53, 0x9d, 0x40, 0xef,
+	0xb8, 0x98, 0x99, 0x87, 0xb0, 0x2b, 0x58, 0xce, 0x88, 0xb2, 0x80, 0x03, 0xdc, 0xbe, 0xaf, 0xc9,
+	0xb4, 0x61, 0x3f, 0xc9, 0x14, 0x13, 0x4b, 0x92, 0x5a, 0x5b, 0x0e, 0x70, 0x3b, 0xfe, 0x2f, 0x9b,
+	0x16, 0xec, 0xe5, 0xa4, 0x48, 0x39, 0xa1, 0xd6, 0xb6, 0x03, 0xdc, 0x5d, 0xbf, 0xc5, 0x8b, 0xce,
+	0xd7, 0xf3, 0x29, 0x18, 0x5c, 0xc3, 0xfd, 0x5b, 0x92, 0x26, 0x94, 0x28, 0x2e, 0xae, 0x28, 0x15,
+	0x4c, 0x4a, 0xf3, 0x12, 0xf6, 0x48, 0x33, 0xd6, 0x41, 0x3b, 0x93, 0xb3, 0xb7, 0xd7, 0xe1, 0x89,
+	0xfe, 0xf8, 0xbf, 0x3d, 0x55, 0x22, 0xc9, 0x22, 0xbf, 0xbd, 0x98, 0xdc, 0xac, 0x3e, 0x91, 0xf1,
+	0x52, 0x22, 0x63, 0x55, 0x22, 0xb0, 0x2e, 0x11, 0xf8, 0x28, 0x11, 0x78, 0xaa, 0x90, 0xb1, 0xae,
+	0x90, 0xf1, 0x5e, 0x21, 0xe3, 0x7e, 0x1c, 0x25, 0x2a, 0x5e, 0x04, 0x5e, 0xc8, 0xe7, 0x78, 0x53,
+	0xbf, 0x43, 0x49, 0x67, 0xf8, 0xb1, 0x25, 0xac, 0x8a, 0x9c, 0xc9, 0xa0, 0x5b, 0x77, 0x32, 0xfe,
+	0x1e, 0x00, 0x5c, 0x79, 0x45, 0xf0, 0x92, 0x01, 0x00, 0x00,
 }
 
+func (this *ScheduledWork) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledWork)
+	if !ok {
+		that2, ok := that.(ScheduledWork)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Repeat != that1.Repeat {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
 func (m *ScheduledWork) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -163,6 +191,18 @@ func (m *ScheduledWork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Repeat {
 		i--
 		if m.Repeat {
@@ -217,7 +257,6 @@ func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-
 func (m *ScheduledWork) Size() (n int) {
 	if m == nil {
 		return 0
@@ -227,6 +266,13 @@ func (m *ScheduledWork) Size() (n int) {
 	if m.Repeat {
 		n += 2
 	}
+	if m.Interval != 0 {
+		n += 1 + sovScheduler(uint64(m.Interval))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
 	return n
 }
 
@@ -246,11 +292,9 @@ func (m *ValidatorAddress) Size() (n int) {
 func sovScheduler(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-
 func sozScheduler(x uint64) (n int) {
 	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-
 func (m *ScheduledWork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -300,6 +344,59 @@ func (m *ScheduledWork) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Repeat = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScheduler(dAtA[iNdEx:])
@@ -321,7 +418,6 @@ func (m *ScheduledWork) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
 func (m *ValidatorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -404,7 +500,6 @@ func (m *ValidatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-
 func skipScheduler(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
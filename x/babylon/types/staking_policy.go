@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultStakingMsgPolicy returns the default staking message policy that
+// denies staking messages to all contracts
+func DefaultStakingMsgPolicy() StakingMsgPolicy {
+	return StakingMsgPolicy{}
+}
+
+// ValidateBasic performs basic validation on a staking message policy.
+func (p StakingMsgPolicy) ValidateBasic() error {
+	seen := make(map[string]struct{}, len(p.AllowedContracts)+len(p.DeniedContracts))
+	for _, addrs := range [][]string{p.AllowedContracts, p.DeniedContracts} {
+		for _, addr := range addrs {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return ErrInvalid.Wrapf("contract address %q: %s", addr, err)
+			}
+			if _, ok := seen[addr]; ok {
+				return ErrInvalid.Wrapf("duplicate contract address: %s", addr)
+			}
+			seen[addr] = struct{}{}
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -112,229 +113,3361 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylonchain.babylon.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylonchain.babylon.v1beta1.MsgUpdateParamsResponse")
+// MsgResumeHooks is the Msg/ResumeHooks request type.
+type MsgResumeHooks struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the address of the contract whose block hooks are
+	// resumed.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylonchain/babylon/v1beta1/tx.proto", fileDescriptor_bc77522e78a3430e)
+func (m *MsgResumeHooks) Reset()         { *m = MsgResumeHooks{} }
+func (m *MsgResumeHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHooks) ProtoMessage()    {}
+func (*MsgResumeHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{2}
 }
-
-var fileDescriptor_bc77522e78a3430e = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x72, 0xf4, 0xcb, 0x0c, 0x93, 0x52,
-	0x4b, 0x12, 0x0d, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x90, 0x95,
-	0xe9, 0x41, 0x39, 0x7a, 0x50, 0x65, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x85, 0xfa, 0x20,
-	0x16, 0x44, 0x8f, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0xba, 0x7e,
-	0x99, 0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0x21, 0x3a, 0x20, 0x1c, 0xa8, 0x94, 0x16,
-	0x5e, 0xe7, 0xc0, 0xec, 0x05, 0xab, 0x55, 0x9a, 0xcb, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a,
-	0x90, 0x92, 0x58, 0x92, 0x1a, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58,
-	0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71,
-	0x69, 0x8b, 0xae, 0x08, 0xd4, 0x12, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2,
-	0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x27, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x2a, 0x7a, 0xf8, 0x3c, 0xac, 0x07, 0xb1, 0xcd, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x4e, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x66, 0x2a, 0x49, 0x72,
-	0x89, 0xa3, 0x39, 0x2f, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0xa8, 0x89, 0x91, 0x8b,
-	0xd9, 0xb7, 0x38, 0x5d, 0xa8, 0x84, 0x8b, 0x07, 0xc5, 0xf9, 0xba, 0xf8, 0xad, 0x45, 0x33, 0x4e,
-	0xca, 0x94, 0x24, 0xe5, 0x30, 0xdb, 0xa5, 0x58, 0x1b, 0x9e, 0x6f, 0xd0, 0x62, 0x74, 0x0a, 0x3c,
-	0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd3,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xb1, 0x45, 0x8a, 0x6e, 0x71, 0x4a,
-	0xb6, 0x7e, 0x05, 0x3c, 0x8a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x31, 0x63, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x52, 0xc6, 0x24, 0xe7, 0x56, 0x02, 0x00, 0x00,
+func (m *MsgResumeHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHooks.Merge(m, src)
+}
+func (m *MsgResumeHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHooks.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgResumeHooks proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the x/auth
-	// module parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgResumeHooksResponse defines the response structure for executing a
+// MsgResumeHooks message.
+type MsgResumeHooksResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgResumeHooksResponse) Reset()         { *m = MsgResumeHooksResponse{} }
+func (m *MsgResumeHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeHooksResponse) ProtoMessage()    {}
+func (*MsgResumeHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{3}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgResumeHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResumeHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the x/auth
-	// module parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgResumeHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeHooksResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgResumeHooksResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgResumeHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeHooksResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgResumeHooksResponse proto.InternalMessageInfo
+
+// MsgSetContractAuthorization is the Msg/SetContractAuthorization request type.
+type MsgSetContractAuthorization struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// authorization defines the contract and the custom message types it may
+	// dispatch. An existing authorization of the contract is replaced.
+	Authorization ContractAuthorization `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylonchain.babylon.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSetContractAuthorization) Reset()         { *m = MsgSetContractAuthorization{} }
+func (m *MsgSetContractAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractAuthorization) ProtoMessage()    {}
+func (*MsgSetContractAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{4}
+}
+func (m *MsgSetContractAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetContractAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractAuthorization.Merge(m, src)
+}
+func (m *MsgSetContractAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractAuthorization.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylonchain.babylon.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylonchain/babylon/v1beta1/tx.proto",
+var xxx_messageInfo_MsgSetContractAuthorization proto.InternalMessageInfo
+
+// MsgSetContractAuthorizationResponse defines the response structure for
+// executing a MsgSetContractAuthorization message.
+type MsgSetContractAuthorizationResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractAuthorizationResponse) Reset()         { *m = MsgSetContractAuthorizationResponse{} }
+func (m *MsgSetContractAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractAuthorizationResponse) ProtoMessage()    {}
+func (*MsgSetContractAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{5}
+}
+func (m *MsgSetContractAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetContractAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgSetContractAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractAuthorizationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetContractAuthorizationResponse proto.InternalMessageInfo
+
+// MsgRemoveContractAuthorization is the Msg/RemoveContractAuthorization request
+// type.
+type MsgRemoveContractAuthorization struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the address of the contract whose authorization is
+	// removed.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveContractAuthorization) Reset()         { *m = MsgRemoveContractAuthorization{} }
+func (m *MsgRemoveContractAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractAuthorization) ProtoMessage()    {}
+func (*MsgRemoveContractAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{6}
+}
+func (m *MsgRemoveContractAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveContractAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveContractAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractAuthorization.Merge(m, src)
+}
+func (m *MsgRemoveContractAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveContractAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractAuthorization.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgRemoveContractAuthorization proto.InternalMessageInfo
+
+// MsgRemoveContractAuthorizationResponse defines the response structure for
+// executing a MsgRemoveContractAuthorization message.
+type MsgRemoveContractAuthorizationResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveContractAuthorizationResponse) Reset() {
+	*m = MsgRemoveContractAuthorizationResponse{}
+}
+func (m *MsgRemoveContractAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractAuthorizationResponse) ProtoMessage()    {}
+func (*MsgRemoveContractAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{7}
+}
+func (m *MsgRemoveContractAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveContractAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveContractAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgRemoveContractAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveContractAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractAuthorizationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveContractAuthorizationResponse proto.InternalMessageInfo
+
+// MsgUpdateStakingMsgPolicy is the Msg/UpdateStakingMsgPolicy request type.
+type MsgUpdateStakingMsgPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy defines the staking message policy to set.
+	Policy StakingMsgPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateStakingMsgPolicy) Reset()         { *m = MsgUpdateStakingMsgPolicy{} }
+func (m *MsgUpdateStakingMsgPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStakingMsgPolicy) ProtoMessage()    {}
+func (*MsgUpdateStakingMsgPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{8}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateStakingMsgPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStakingMsgPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStakingMsgPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
 }
+func (m *MsgUpdateStakingMsgPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStakingMsgPolicy.Merge(m, src)
+}
+func (m *MsgUpdateStakingMsgPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStakingMsgPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStakingMsgPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateStakingMsgPolicy proto.InternalMessageInfo
+
+// MsgUpdateStakingMsgPolicyResponse defines the response structure for
+// executing a MsgUpdateStakingMsgPolicy message.
+type MsgUpdateStakingMsgPolicyResponse struct {
+}
+
+func (m *MsgUpdateStakingMsgPolicyResponse) Reset()         { *m = MsgUpdateStakingMsgPolicyResponse{} }
+func (m *MsgUpdateStakingMsgPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStakingMsgPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateStakingMsgPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{9}
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStakingMsgPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStakingMsgPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStakingMsgPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateStakingMsgPolicyResponse proto.InternalMessageInfo
+
+// MsgInstantiateBabylonContracts is the Msg/InstantiateBabylonContracts request
+// type.
+type MsgInstantiateBabylonContracts struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// babylon_contract_code_id is the code ID of the Babylon contract
+	BabylonContractCodeId uint64 `protobuf:"varint,2,opt,name=babylon_contract_code_id,json=babylonContractCodeId,proto3" json:"babylon_contract_code_id,omitempty"`
+	// btc_staking_contract_code_id is the code ID of the BTC staking contract
+	BtcStakingContractCodeId uint64 `protobuf:"varint,3,opt,name=btc_staking_contract_code_id,json=btcStakingContractCodeId,proto3" json:"btc_staking_contract_code_id,omitempty"`
+	// babylon_init_msg is the JSON encoded instantiate message of the Babylon
+	// contract. The btc_staking_code_id and btc_staking_msg fields are set by
+	// the module.
+	BabylonInitMsg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,4,opt,name=babylon_init_msg,json=babylonInitMsg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"babylon_init_msg,omitempty"`
+	// btc_staking_init_msg is the JSON encoded instantiate message of the BTC
+	// staking contract
+	BtcStakingInitMsg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=btc_staking_init_msg,json=btcStakingInitMsg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"btc_staking_init_msg,omitempty"`
+	// admin is the optional admin of the Babylon contract
+	Admin string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *MsgInstantiateBabylonContracts) Reset()         { *m = MsgInstantiateBabylonContracts{} }
+func (m *MsgInstantiateBabylonContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateBabylonContracts) ProtoMessage()    {}
+func (*MsgInstantiateBabylonContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{10}
+}
+func (m *MsgInstantiateBabylonContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateBabylonContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateBabylonContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateBabylonContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateBabylonContracts.Merge(m, src)
+}
+func (m *MsgInstantiateBabylonContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateBabylonContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateBabylonContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateBabylonContracts proto.InternalMessageInfo
+
+// MsgInstantiateBabylonContractsResponse defines the response structure for
+// executing a MsgInstantiateBabylonContracts message.
+type MsgInstantiateBabylonContractsResponse struct {
+	// babylon_contract_address is the address of the Babylon contract
+	BabylonContractAddress string `protobuf:"bytes,1,opt,name=babylon_contract_address,json=babylonContractAddress,proto3" json:"babylon_contract_address,omitempty"`
+	// btc_staking_contract_address is the address of the BTC staking contract
+	BtcStakingContractAddress string `protobuf:"bytes,2,opt,name=btc_staking_contract_address,json=btcStakingContractAddress,proto3" json:"btc_staking_contract_address,omitempty"`
+}
+
+func (m *MsgInstantiateBabylonContractsResponse) Reset() {
+	*m = MsgInstantiateBabylonContractsResponse{}
+}
+func (m *MsgInstantiateBabylonContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateBabylonContractsResponse) ProtoMessage()    {}
+func (*MsgInstantiateBabylonContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{11}
+}
+func (m *MsgInstantiateBabylonContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateBabylonContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateBabylonContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateBabylonContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateBabylonContractsResponse.Merge(m, src)
+}
+func (m *MsgInstantiateBabylonContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateBabylonContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateBabylonContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateBabylonContractsResponse proto.InternalMessageInfo
+
+// MsgUpdateCodePins is the Msg/UpdateCodePins request type.
+type MsgUpdateCodePins struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pins defines the new code pins of the Babylon contracts.
+	Pins CodePins `protobuf:"bytes,2,opt,name=pins,proto3" json:"pins"`
+}
+
+func (m *MsgUpdateCodePins) Reset()         { *m = MsgUpdateCodePins{} }
+func (m *MsgUpdateCodePins) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodePins) ProtoMessage()    {}
+func (*MsgUpdateCodePins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{12}
+}
+func (m *MsgUpdateCodePins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodePins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodePins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCodePins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodePins.Merge(m, src)
+}
+func (m *MsgUpdateCodePins) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodePins) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodePins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCodePins proto.InternalMessageInfo
+
+// MsgUpdateCodePinsResponse defines the response structure for executing a
+// MsgUpdateCodePins message.
+type MsgUpdateCodePinsResponse struct {
+}
+
+func (m *MsgUpdateCodePinsResponse) Reset()         { *m = MsgUpdateCodePinsResponse{} }
+func (m *MsgUpdateCodePinsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodePinsResponse) ProtoMessage()    {}
+func (*MsgUpdateCodePinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{13}
+}
+func (m *MsgUpdateCodePinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodePinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodePinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCodePinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodePinsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCodePinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodePinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodePinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCodePinsResponse proto.InternalMessageInfo
+
+// MsgRegisterHook is the Msg/RegisterHook request type.
+type MsgRegisterHook struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// registration defines the contract, hook, gas limit and priority. An
+	// existing registration of the contract for the same hook is replaced.
+	Registration HookRegistration `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration"`
+}
+
+func (m *MsgRegisterHook) Reset()         { *m = MsgRegisterHook{} }
+func (m *MsgRegisterHook) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterHook) ProtoMessage()    {}
+func (*MsgRegisterHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{14}
+}
+func (m *MsgRegisterHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterHook.Merge(m, src)
+}
+func (m *MsgRegisterHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterHook proto.InternalMessageInfo
+
+// MsgRegisterHookResponse defines the response structure for executing a
+// MsgRegisterHook message.
+type MsgRegisterHookResponse struct {
+}
+
+func (m *MsgRegisterHookResponse) Reset()         { *m = MsgRegisterHookResponse{} }
+func (m *MsgRegisterHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterHookResponse) ProtoMessage()    {}
+func (*MsgRegisterHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{15}
+}
+func (m *MsgRegisterHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterHookResponse.Merge(m, src)
+}
+func (m *MsgRegisterHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterHookResponse proto.InternalMessageInfo
+
+// MsgDeregisterHook is the Msg/DeregisterHook request type.
+type MsgDeregisterHook struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the address of the contract to deregister
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// hook is the block hook, "begin_block" or "end_block"
+	Hook string `protobuf:"bytes,3,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (m *MsgDeregisterHook) Reset()         { *m = MsgDeregisterHook{} }
+func (m *MsgDeregisterHook) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterHook) ProtoMessage()    {}
+func (*MsgDeregisterHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{16}
+}
+func (m *MsgDeregisterHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterHook.Merge(m, src)
+}
+func (m *MsgDeregisterHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterHook proto.InternalMessageInfo
+
+// MsgDeregisterHookResponse defines the response structure for executing a
+// MsgDeregisterHook message.
+type MsgDeregisterHookResponse struct {
+}
+
+func (m *MsgDeregisterHookResponse) Reset()         { *m = MsgDeregisterHookResponse{} }
+func (m *MsgDeregisterHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterHookResponse) ProtoMessage()    {}
+func (*MsgDeregisterHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc77522e78a3430e, []int{17}
+}
+func (m *MsgDeregisterHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterHookResponse.Merge(m, src)
+}
+func (m *MsgDeregisterHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterHookResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylonchain.babylon.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylonchain.babylon.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResumeHooks)(nil), "babylonchain.babylon.v1beta1.MsgResumeHooks")
+	proto.RegisterType((*MsgResumeHooksResponse)(nil), "babylonchain.babylon.v1beta1.MsgResumeHooksResponse")
+	proto.RegisterType((*MsgSetContractAuthorization)(nil), "babylonchain.babylon.v1beta1.MsgSetContractAuthorization")
+	proto.RegisterType((*MsgSetContractAuthorizationResponse)(nil), "babylonchain.babylon.v1beta1.MsgSetContractAuthorizationResponse")
+	proto.RegisterType((*MsgRemoveContractAuthorization)(nil), "babylonchain.babylon.v1beta1.MsgRemoveContractAuthorization")
+	proto.RegisterType((*MsgRemoveContractAuthorizationResponse)(nil), "babylonchain.babylon.v1beta1.MsgRemoveContractAuthorizationResponse")
+	proto.RegisterType((*MsgUpdateStakingMsgPolicy)(nil), "babylonchain.babylon.v1beta1.MsgUpdateStakingMsgPolicy")
+	proto.RegisterType((*MsgUpdateStakingMsgPolicyResponse)(nil), "babylonchain.babylon.v1beta1.MsgUpdateStakingMsgPolicyResponse")
+	proto.RegisterType((*MsgInstantiateBabylonContracts)(nil), "babylonchain.babylon.v1beta1.MsgInstantiateBabylonContracts")
+	proto.RegisterType((*MsgInstantiateBabylonContractsResponse)(nil), "babylonchain.babylon.v1beta1.MsgInstantiateBabylonContractsResponse")
+	proto.RegisterType((*MsgUpdateCodePins)(nil), "babylonchain.babylon.v1beta1.MsgUpdateCodePins")
+	proto.RegisterType((*MsgUpdateCodePinsResponse)(nil), "babylonchain.babylon.v1beta1.MsgUpdateCodePinsResponse")
+	proto.RegisterType((*MsgRegisterHook)(nil), "babylonchain.babylon.v1beta1.MsgRegisterHook")
+	proto.RegisterType((*MsgRegisterHookResponse)(nil), "babylonchain.babylon.v1beta1.MsgRegisterHookResponse")
+	proto.RegisterType((*MsgDeregisterHook)(nil), "babylonchain.babylon.v1beta1.MsgDeregisterHook")
+	proto.RegisterType((*MsgDeregisterHookResponse)(nil), "babylonchain.babylon.v1beta1.MsgDeregisterHookResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylonchain/babylon/v1beta1/tx.proto", fileDescriptor_bc77522e78a3430e)
+}
+
+var fileDescriptor_bc77522e78a3430e = []byte{
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xde, 0x69, 0x36, 0x91, 0xf2, 0x36, 0xa4, 0xad, 0x15, 0x52, 0xc7, 0xa9, 0xb6, 0xa1, 0xa5,
+	0x51, 0x14, 0x11, 0x5b, 0x69, 0x28, 0x51, 0x11, 0x5f, 0xdd, 0xe4, 0x40, 0x24, 0x56, 0x0a, 0x8e,
+	0x10, 0x1f, 0x97, 0xd5, 0xac, 0x3d, 0x72, 0x46, 0xa9, 0x3d, 0x8b, 0x67, 0x92, 0x26, 0x3d, 0x21,
+	0x7e, 0x01, 0xe2, 0xc4, 0x85, 0x03, 0x3f, 0x00, 0xa9, 0x88, 0xfe, 0x06, 0x94, 0x63, 0xc5, 0x05,
+	0x24, 0xa4, 0x0a, 0x92, 0x43, 0x25, 0x7e, 0x02, 0x27, 0xb4, 0xf6, 0x78, 0x62, 0xef, 0xc6, 0xf6,
+	0xae, 0x9b, 0x9e, 0x76, 0x67, 0xe7, 0x7d, 0xde, 0xf7, 0x79, 0xe6, 0x79, 0xe7, 0x63, 0xe1, 0x4e,
+	0x07, 0x77, 0x8e, 0x1e, 0xb2, 0xc0, 0xd9, 0xc5, 0x34, 0xb0, 0xe4, 0xc0, 0x3a, 0x58, 0xed, 0x10,
+	0x81, 0x57, 0x2d, 0x71, 0x68, 0x76, 0x43, 0x26, 0x98, 0x76, 0x23, 0x1d, 0x66, 0xca, 0x81, 0x29,
+	0xc3, 0x8c, 0x19, 0x8f, 0x79, 0x2c, 0x0a, 0xb4, 0x7a, 0xdf, 0x62, 0x8c, 0x71, 0xdd, 0x61, 0xdc,
+	0x67, 0xdc, 0xf2, 0xb9, 0x67, 0x1d, 0xac, 0xf6, 0x3e, 0xe4, 0xc4, 0x5c, 0x3c, 0xd1, 0x8e, 0x11,
+	0xf1, 0x40, 0x4e, 0x2d, 0x17, 0xd2, 0x49, 0xea, 0x46, 0xb1, 0xb7, 0x7e, 0x44, 0x70, 0xa5, 0xc5,
+	0xbd, 0xcf, 0xba, 0x2e, 0x16, 0x64, 0x1b, 0x87, 0xd8, 0xe7, 0xda, 0x3b, 0x30, 0x89, 0xf7, 0xc5,
+	0x2e, 0x0b, 0xa9, 0x38, 0xd2, 0xd1, 0x02, 0x5a, 0x9a, 0x6c, 0xea, 0xbf, 0x3f, 0x5d, 0x99, 0x91,
+	0x45, 0x1e, 0xb8, 0x6e, 0x48, 0x38, 0xdf, 0x11, 0x21, 0x0d, 0x3c, 0xfb, 0x2c, 0x54, 0x6b, 0xc2,
+	0x44, 0x37, 0xca, 0xa0, 0x5f, 0x5a, 0x40, 0x4b, 0x97, 0xef, 0xbe, 0x69, 0x16, 0x09, 0x36, 0xe3,
+	0x6a, 0xcd, 0xfa, 0xf1, 0xf3, 0x9b, 0x35, 0x5b, 0x22, 0xdf, 0x9d, 0xfe, 0xf6, 0xc5, 0x93, 0xe5,
+	0xb3, 0x9c, 0xb7, 0xe6, 0xe0, 0x7a, 0x1f, 0x3d, 0x9b, 0xf0, 0x2e, 0x0b, 0x38, 0xe9, 0x51, 0x9f,
+	0x6e, 0x71, 0xcf, 0x26, 0x7c, 0xdf, 0x27, 0x1f, 0x33, 0xb6, 0x57, 0x9d, 0xf9, 0x06, 0x5c, 0x75,
+	0x58, 0x20, 0x42, 0xec, 0x88, 0x36, 0x8e, 0x83, 0xf4, 0x4b, 0x25, 0xf0, 0x2b, 0x09, 0x42, 0xfe,
+	0x3c, 0x40, 0x5d, 0x87, 0xd9, 0x2c, 0x3d, 0xc5, 0xfc, 0x37, 0x04, 0xf3, 0x2d, 0xee, 0xed, 0x10,
+	0xb1, 0x91, 0xe4, 0x88, 0x51, 0x8f, 0xb1, 0xa0, 0x2c, 0xa8, 0x2c, 0xa3, 0x0d, 0xaf, 0xe1, 0x74,
+	0x22, 0xe9, 0xc3, 0x5a, 0xb1, 0x0f, 0xe7, 0x72, 0x90, 0xb6, 0x64, 0xf3, 0x0d, 0x48, 0xbc, 0x03,
+	0xb7, 0x0b, 0x74, 0x28, 0xbd, 0x3f, 0x23, 0x68, 0x44, 0x4b, 0xe1, 0xb3, 0x03, 0x72, 0xb1, 0x92,
+	0x5f, 0x89, 0x73, 0x4b, 0xb0, 0x58, 0x4c, 0x57, 0x29, 0xfb, 0x05, 0xc1, 0x9c, 0xea, 0xcf, 0x1d,
+	0x81, 0xf7, 0x68, 0xe0, 0xb5, 0xb8, 0xb7, 0xcd, 0x1e, 0x52, 0xe7, 0xa8, 0xb2, 0xa8, 0x4f, 0x60,
+	0xa2, 0x1b, 0x65, 0x90, 0x06, 0x9a, 0xc5, 0x06, 0xf6, 0xd7, 0x55, 0x5b, 0x2a, 0x1a, 0x0d, 0xa8,
+	0xbb, 0x0d, 0x6f, 0xe4, 0x52, 0x56, 0xc2, 0xfe, 0x1d, 0x8b, 0x2c, 0xdb, 0x0a, 0xb8, 0xc0, 0x81,
+	0xa0, 0x58, 0x90, 0x66, 0x5c, 0x35, 0x59, 0x8f, 0xea, 0x9b, 0x6d, 0x1d, 0x74, 0xa9, 0xa0, 0xad,
+	0xac, 0x73, 0x98, 0x4b, 0xda, 0xd4, 0x8d, 0xf4, 0xd6, 0xed, 0xd7, 0x3b, 0xd9, 0x5a, 0x1b, 0xcc,
+	0x25, 0x5b, 0xae, 0xf6, 0x01, 0xdc, 0xe8, 0x08, 0xa7, 0xcd, 0x63, 0xce, 0x83, 0xe0, 0xb1, 0x08,
+	0xac, 0x77, 0x84, 0x23, 0x65, 0xf5, 0xe1, 0x3d, 0xb8, 0x9a, 0x14, 0xa6, 0x01, 0x15, 0x6d, 0x9f,
+	0x7b, 0x7a, 0x7d, 0x01, 0x2d, 0x4d, 0x35, 0xdf, 0xff, 0xef, 0xf9, 0xcd, 0xfb, 0x1e, 0x15, 0xbb,
+	0xfb, 0x1d, 0xd3, 0x61, 0xbe, 0xb5, 0xc1, 0xb8, 0xff, 0x39, 0xe6, 0xbe, 0xf5, 0x08, 0x73, 0xdf,
+	0xb5, 0x0e, 0xa3, 0x4f, 0x4b, 0x1c, 0x75, 0x09, 0x37, 0x6d, 0xfc, 0x28, 0xc9, 0xdb, 0x22, 0x9c,
+	0x63, 0x8f, 0xd8, 0xd3, 0x32, 0xed, 0x56, 0x40, 0x45, 0x8b, 0x7b, 0x5a, 0x00, 0x33, 0x69, 0xa2,
+	0xaa, 0xd8, 0xf8, 0x45, 0x14, 0xbb, 0x76, 0xa6, 0x2f, 0xa9, 0x67, 0xc2, 0x38, 0x76, 0x7d, 0x1a,
+	0xe8, 0x13, 0x25, 0x2e, 0xc4, 0x61, 0x03, 0x1d, 0xf1, 0x07, 0x82, 0xc5, 0x62, 0xb3, 0x93, 0xbe,
+	0xd0, 0xec, 0x73, 0xcc, 0x4b, 0xf6, 0x5d, 0x59, 0x0f, 0xcc, 0xf6, 0xd9, 0x2a, 0x67, 0xb5, 0x2f,
+	0x73, 0x7c, 0x1d, 0x76, 0x3f, 0xcf, 0x0d, 0x3a, 0x2e, 0x03, 0x7a, 0x77, 0xc4, 0x35, 0xd5, 0xec,
+	0xbd, 0x36, 0xd8, 0xa6, 0x41, 0xf5, 0xce, 0xfd, 0x08, 0xea, 0x5d, 0x1a, 0x24, 0xd7, 0xdb, 0x62,
+	0xd9, 0xb1, 0x1a, 0x57, 0x93, 0xbb, 0x31, 0x42, 0x0e, 0xac, 0xfc, 0x7c, 0xea, 0xf8, 0x48, 0x00,
+	0x6a, 0x0f, 0xfe, 0x1a, 0xdf, 0xcd, 0x36, 0xf1, 0x28, 0x17, 0x24, 0xec, 0xdd, 0x21, 0x95, 0xa9,
+	0x7f, 0x01, 0x53, 0x61, 0x94, 0x27, 0x4c, 0xdf, 0x0c, 0x25, 0x07, 0x4b, 0xaf, 0xa2, 0x9d, 0x42,
+	0x49, 0x29, 0x99, 0x4c, 0x39, 0x37, 0x76, 0x9a, 0xb4, 0x12, 0xf4, 0x34, 0x76, 0x63, 0x93, 0x84,
+	0x17, 0x21, 0xe9, 0x22, 0x8e, 0x7e, 0x4d, 0x83, 0xfa, 0x2e, 0x63, 0x7b, 0xd1, 0xd9, 0x31, 0x69,
+	0x47, 0xdf, 0x73, 0x4c, 0xca, 0xb2, 0x4e, 0x34, 0xdd, 0xfd, 0x6b, 0x12, 0xc6, 0x7a, 0x7b, 0x50,
+	0xc0, 0x54, 0xe6, 0x11, 0xb5, 0x52, 0xbc, 0xb4, 0x7d, 0x8f, 0x1a, 0xe3, 0xde, 0x48, 0xe1, 0x6a,
+	0x3b, 0x7e, 0x0d, 0x97, 0xd3, 0xef, 0x9f, 0xb7, 0x4a, 0xb3, 0xa4, 0xa2, 0x8d, 0xb7, 0x47, 0x89,
+	0x56, 0x25, 0x7f, 0x40, 0xa0, 0xe7, 0xbe, 0x5c, 0xee, 0x97, 0xa6, 0xcc, 0x83, 0x1a, 0x0f, 0x2a,
+	0x43, 0x15, 0xb5, 0x9f, 0x10, 0xcc, 0x17, 0x3d, 0x32, 0xde, 0x1b, 0x42, 0x70, 0x2e, 0xda, 0xd8,
+	0x7c, 0x19, 0xb4, 0xe2, 0xf8, 0x3d, 0x82, 0xd9, 0x9c, 0xe7, 0xc2, 0xfa, 0x90, 0x3d, 0xd0, 0x0f,
+	0x34, 0x3e, 0xac, 0x08, 0xcc, 0x2c, 0x5c, 0xd1, 0x55, 0x5f, 0xbe, 0x70, 0x05, 0x68, 0x63, 0xf3,
+	0x65, 0xd0, 0x8a, 0xe3, 0x63, 0x98, 0xee, 0x3b, 0xc6, 0xad, 0x21, 0x65, 0x27, 0x00, 0x63, 0x7d,
+	0x44, 0x80, 0xaa, 0x2d, 0x60, 0x2a, 0x73, 0x0a, 0xaf, 0x0c, 0xd1, 0x0a, 0x67, 0xe1, 0xc6, 0xbd,
+	0x91, 0xc2, 0xd3, 0x8a, 0xfb, 0x8e, 0xca, 0x72, 0xc5, 0x59, 0x80, 0xb1, 0x3e, 0x22, 0x20, 0xa9,
+	0x6d, 0x8c, 0x7f, 0xf3, 0xe2, 0xc9, 0x32, 0x6a, 0x7e, 0x7a, 0xfc, 0x4f, 0xa3, 0x76, 0x7c, 0xd2,
+	0x40, 0xcf, 0x4e, 0x1a, 0xe8, 0xef, 0x93, 0x06, 0xfa, 0xee, 0xb4, 0x51, 0x7b, 0x76, 0xda, 0xa8,
+	0xfd, 0x79, 0xda, 0xa8, 0x7d, 0xb5, 0x96, 0x7a, 0xc5, 0x9c, 0xf7, 0x9f, 0x73, 0x85, 0xbb, 0x7b,
+	0xd6, 0x61, 0x32, 0x8a, 0x9f, 0x34, 0x9d, 0x89, 0xe8, 0x8f, 0xe7, 0xda, 0xff, 0x03, 0x00, 0x0c,
+	0xec, 0xe9, 0x61, 0x35, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the x/auth
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResumeHooks defines a (governance) operation for clearing the suspended
+	// status of the block hooks of a contract.
+	ResumeHooks(ctx context.Context, in *MsgResumeHooks, opts ...grpc.CallOption) (*MsgResumeHooksResponse, error)
+	// SetContractAuthorization defines a (governance) operation for creating or
+	// replacing the authorization of a contract to dispatch Babylon custom
+	// messages.
+	SetContractAuthorization(ctx context.Context, in *MsgSetContractAuthorization, opts ...grpc.CallOption) (*MsgSetContractAuthorizationResponse, error)
+	// RemoveContractAuthorization defines a (governance) operation for revoking
+	// the authorization of a contract to dispatch Babylon custom messages.
+	RemoveContractAuthorization(ctx context.Context, in *MsgRemoveContractAuthorization, opts ...grpc.CallOption) (*MsgRemoveContractAuthorizationResponse, error)
+	// UpdateStakingMsgPolicy defines a (governance) operation for replacing the
+	// policy that controls which contracts may dispatch staking messages.
+	UpdateStakingMsgPolicy(ctx context.Context, in *MsgUpdateStakingMsgPolicy, opts ...grpc.CallOption) (*MsgUpdateStakingMsgPolicyResponse, error)
+	// InstantiateBabylonContracts defines a (governance) operation for
+	// instantiating the Babylon contract, which in turn instantiates the BTC
+	// staking contract, and storing both contract addresses in the params.
+	InstantiateBabylonContracts(ctx context.Context, in *MsgInstantiateBabylonContracts, opts ...grpc.CallOption) (*MsgInstantiateBabylonContractsResponse, error)
+	// UpdateCodePins defines a (governance) operation for replacing the code
+	// checksums that the Babylon contracts are pinned to.
+	UpdateCodePins(ctx context.Context, in *MsgUpdateCodePins, opts ...grpc.CallOption) (*MsgUpdateCodePinsResponse, error)
+	// RegisterHook defines a (governance) operation for adding or replacing a
+	// block hook subscription of a contract in the hook registry.
+	RegisterHook(ctx context.Context, in *MsgRegisterHook, opts ...grpc.CallOption) (*MsgRegisterHookResponse, error)
+	// DeregisterHook defines a (governance) operation for removing a block hook
+	// subscription of a contract from the hook registry.
+	DeregisterHook(ctx context.Context, in *MsgDeregisterHook, opts ...grpc.CallOption) (*MsgDeregisterHookResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeHooks(ctx context.Context, in *MsgResumeHooks, opts ...grpc.CallOption) (*MsgResumeHooksResponse, error) {
+	out := new(MsgResumeHooksResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/ResumeHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractAuthorization(ctx context.Context, in *MsgSetContractAuthorization, opts ...grpc.CallOption) (*MsgSetContractAuthorizationResponse, error) {
+	out := new(MsgSetContractAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/SetContractAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContractAuthorization(ctx context.Context, in *MsgRemoveContractAuthorization, opts ...grpc.CallOption) (*MsgRemoveContractAuthorizationResponse, error) {
+	out := new(MsgRemoveContractAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/RemoveContractAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateStakingMsgPolicy(ctx context.Context, in *MsgUpdateStakingMsgPolicy, opts ...grpc.CallOption) (*MsgUpdateStakingMsgPolicyResponse, error) {
+	out := new(MsgUpdateStakingMsgPolicyResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/UpdateStakingMsgPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InstantiateBabylonContracts(ctx context.Context, in *MsgInstantiateBabylonContracts, opts ...grpc.CallOption) (*MsgInstantiateBabylonContractsResponse, error) {
+	out := new(MsgInstantiateBabylonContractsResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/InstantiateBabylonContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCodePins(ctx context.Context, in *MsgUpdateCodePins, opts ...grpc.CallOption) (*MsgUpdateCodePinsResponse, error) {
+	out := new(MsgUpdateCodePinsResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/UpdateCodePins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterHook(ctx context.Context, in *MsgRegisterHook, opts ...grpc.CallOption) (*MsgRegisterHookResponse, error) {
+	out := new(MsgRegisterHookResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/RegisterHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterHook(ctx context.Context, in *MsgDeregisterHook, opts ...grpc.CallOption) (*MsgDeregisterHookResponse, error) {
+	out := new(MsgDeregisterHookResponse)
+	err := c.cc.Invoke(ctx, "/babylonchain.babylon.v1beta1.Msg/DeregisterHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the x/auth
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResumeHooks defines a (governance) operation for clearing the suspended
+	// status of the block hooks of a contract.
+	ResumeHooks(context.Context, *MsgResumeHooks) (*MsgResumeHooksResponse, error)
+	// SetContractAuthorization defines a (governance) operation for creating or
+	// replacing the authorization of a contract to dispatch Babylon custom
+	// messages.
+	SetContractAuthorization(context.Context, *MsgSetContractAuthorization) (*MsgSetContractAuthorizationResponse, error)
+	// RemoveContractAuthorization defines a (governance) operation for revoking
+	// the authorization of a contract to dispatch Babylon custom messages.
+	RemoveContractAuthorization(context.Context, *MsgRemoveContractAuthorization) (*MsgRemoveContractAuthorizationResponse, error)
+	// UpdateStakingMsgPolicy defines a (governance) operation for replacing the
+	// policy that controls which contracts may dispatch staking messages.
+	UpdateStakingMsgPolicy(context.Context, *MsgUpdateStakingMsgPolicy) (*MsgUpdateStakingMsgPolicyResponse, error)
+	// InstantiateBabylonContracts defines a (governance) operation for
+	// instantiating the Babylon contract, which in turn instantiates the BTC
+	// staking contract, and storing both contract addresses in the params.
+	InstantiateBabylonContracts(context.Context, *MsgInstantiateBabylonContracts) (*MsgInstantiateBabylonContractsResponse, error)
+	// UpdateCodePins defines a (governance) operation for replacing the code
+	// checksums that the Babylon contracts are pinned to.
+	UpdateCodePins(context.Context, *MsgUpdateCodePins) (*MsgUpdateCodePinsResponse, error)
+	// RegisterHook defines a (governance) operation for adding or replacing a
+	// block hook subscription of a contract in the hook registry.
+	RegisterHook(context.Context, *MsgRegisterHook) (*MsgRegisterHookResponse, error)
+	// DeregisterHook defines a (governance) operation for removing a block hook
+	// subscription of a contract from the hook registry.
+	DeregisterHook(context.Context, *MsgDeregisterHook) (*MsgDeregisterHookResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResumeHooks(ctx context.Context, req *MsgResumeHooks) (*MsgResumeHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHooks not implemented")
+}
+func (*UnimplementedMsgServer) SetContractAuthorization(ctx context.Context, req *MsgSetContractAuthorization) (*MsgSetContractAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) RemoveContractAuthorization(ctx context.Context, req *MsgRemoveContractAuthorization) (*MsgRemoveContractAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContractAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) UpdateStakingMsgPolicy(ctx context.Context, req *MsgUpdateStakingMsgPolicy) (*MsgUpdateStakingMsgPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingMsgPolicy not implemented")
+}
+func (*UnimplementedMsgServer) InstantiateBabylonContracts(ctx context.Context, req *MsgInstantiateBabylonContracts) (*MsgInstantiateBabylonContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateBabylonContracts not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCodePins(ctx context.Context, req *MsgUpdateCodePins) (*MsgUpdateCodePinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCodePins not implemented")
+}
+func (*UnimplementedMsgServer) RegisterHook(ctx context.Context, req *MsgRegisterHook) (*MsgRegisterHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHook not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterHook(ctx context.Context, req *MsgDeregisterHook) (*MsgDeregisterHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterHook not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/ResumeHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeHooks(ctx, req.(*MsgResumeHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/SetContractAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractAuthorization(ctx, req.(*MsgSetContractAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContractAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContractAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContractAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/RemoveContractAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContractAuthorization(ctx, req.(*MsgRemoveContractAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateStakingMsgPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStakingMsgPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateStakingMsgPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/UpdateStakingMsgPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateStakingMsgPolicy(ctx, req.(*MsgUpdateStakingMsgPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantiateBabylonContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateBabylonContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateBabylonContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/InstantiateBabylonContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateBabylonContracts(ctx, req.(*MsgInstantiateBabylonContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCodePins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCodePins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCodePins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/UpdateCodePins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCodePins(ctx, req.(*MsgUpdateCodePins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/RegisterHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterHook(ctx, req.(*MsgRegisterHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonchain.babylon.v1beta1.Msg/DeregisterHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterHook(ctx, req.(*MsgDeregisterHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylonchain.babylon.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResumeHooks",
+			Handler:    _Msg_ResumeHooks_Handler,
+		},
+		{
+			MethodName: "SetContractAuthorization",
+			Handler:    _Msg_SetContractAuthorization_Handler,
+		},
+		{
+			MethodName: "RemoveContractAuthorization",
+			Handler:    _Msg_RemoveContractAuthorization_Handler,
+		},
+		{
+			MethodName: "UpdateStakingMsgPolicy",
+			Handler:    _Msg_UpdateStakingMsgPolicy_Handler,
+		},
+		{
+			MethodName: "InstantiateBabylonContracts",
+			Handler:    _Msg_InstantiateBabylonContracts_Handler,
+		},
+		{
+			MethodName: "UpdateCodePins",
+			Handler:    _Msg_UpdateCodePins_Handler,
+		},
+		{
+			MethodName: "RegisterHook",
+			Handler:    _Msg_RegisterHook_Handler,
+		},
+		{
+			MethodName: "DeregisterHook",
+			Handler:    _Msg_DeregisterHook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylonchain/babylon/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveContractAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveContractAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveContractAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveContractAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveContractAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveContractAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStakingMsgPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStakingMsgPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStakingMsgPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStakingMsgPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStakingMsgPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStakingMsgPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateBabylonContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateBabylonContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateBabylonContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BtcStakingInitMsg) > 0 {
+		i -= len(m.BtcStakingInitMsg)
+		copy(dAtA[i:], m.BtcStakingInitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcStakingInitMsg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BabylonInitMsg) > 0 {
+		i -= len(m.BabylonInitMsg)
+		copy(dAtA[i:], m.BabylonInitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BabylonInitMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BtcStakingContractCodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BtcStakingContractCodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BabylonContractCodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BabylonContractCodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateBabylonContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateBabylonContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateBabylonContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcStakingContractAddress) > 0 {
+		i -= len(m.BtcStakingContractAddress)
+		copy(dAtA[i:], m.BtcStakingContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcStakingContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BabylonContractAddress) > 0 {
+		i -= len(m.BabylonContractAddress)
+		copy(dAtA[i:], m.BabylonContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BabylonContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodePins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodePins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodePins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pins.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodePinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodePinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodePinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Registration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authorization.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetContractAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveContractAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveContractAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateStakingMsgPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateStakingMsgPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantiateBabylonContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonContractCodeId != 0 {
+		n += 1 + sovTx(uint64(m.BabylonContractCodeId))
+	}
+	if m.BtcStakingContractCodeId != 0 {
+		n += 1 + sovTx(uint64(m.BtcStakingContractCodeId))
+	}
+	l = len(m.BabylonInitMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcStakingInitMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateBabylonContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BabylonContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcStakingContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCodePins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pins.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCodePinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Registration.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStakingMsgPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingMsgPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingMsgPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStakingMsgPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingMsgPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingMsgPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateBabylonContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateBabylonContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateBabylonContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractCodeId", wireType)
+			}
+			m.BabylonContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractCodeId", wireType)
+			}
+			m.BtcStakingContractCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcStakingContractCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonInitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonInitMsg = append(m.BabylonInitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.BabylonInitMsg == nil {
+				m.BabylonInitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingInitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingInitMsg = append(m.BtcStakingInitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcStakingInitMsg == nil {
+				m.BtcStakingInitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateBabylonContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateBabylonContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateBabylonContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCodePins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCodePins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCodePins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateCodePinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCodePinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCodePinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -357,10 +3490,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -397,7 +3530,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -424,7 +3557,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Registration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -449,7 +3582,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -472,10 +3605,206 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
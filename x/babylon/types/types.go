@@ -10,3 +10,53 @@ const (
 	// SchedulerTaskValsetUpdate triggered by any update on the active set. This includes add, remove, validator modifications, slashing, tombstone
 	SchedulerTaskValsetUpdate = 2
 )
+
+const (
+	// SudoHookBeginBlock identifies the sudo call sent to contracts at BeginBlock
+	SudoHookBeginBlock = "begin_block"
+	// SudoHookEndBlock identifies the sudo call sent to contracts at EndBlock
+	SudoHookEndBlock = "end_block"
+)
+
+const (
+	// SudoHookScheduledTask identifies the sudo call sent to contracts for their scheduled work
+	SudoHookScheduledTask = "scheduled_task"
+	// SudoHookValsetUpdate identifies the sudo call sent to the BTC staking contract on validator set changes
+	SudoHookValsetUpdate = "valset_update"
+)
+
+// SchedulerPhase is the block phase in which scheduled work is executed
+type SchedulerPhase byte
+
+const (
+	// SchedulerPhaseUndefined null value
+	SchedulerPhaseUndefined SchedulerPhase = 0
+	// SchedulerPhaseBeginBlock executes the scheduled work in BeginBlock
+	SchedulerPhaseBeginBlock SchedulerPhase = 1
+	// SchedulerPhaseEndBlock executes the scheduled work in EndBlock
+	SchedulerPhaseEndBlock SchedulerPhase = 2
+)
+
+// SchedulerPhaseFromHook returns the scheduler phase of the given block hook name
+func SchedulerPhaseFromHook(hook string) (SchedulerPhase, error) {
+	switch hook {
+	case SudoHookBeginBlock:
+		return SchedulerPhaseBeginBlock, nil
+	case SudoHookEndBlock:
+		return SchedulerPhaseEndBlock, nil
+	default:
+		return SchedulerPhaseUndefined, ErrInvalid.Wrapf("unknown scheduler phase: %q", hook)
+	}
+}
+
+// Hook returns the name of the block hook of the scheduler phase
+func (p SchedulerPhase) Hook() string {
+	switch p {
+	case SchedulerPhaseBeginBlock:
+		return SudoHookBeginBlock
+	case SchedulerPhaseEndBlock:
+		return SudoHookEndBlock
+	default:
+		return ""
+	}
+}